@@ -0,0 +1,12 @@
+# Review project changes made in the last day
+data "wiz_audit_logs" "project_changes" {
+  since       = timeadd(plantimestamp(), "-24h")
+  action_type = "UpdateProject"
+  max_results = 100
+}
+
+output "project_changes" {
+  value = [
+    for e in data.wiz_audit_logs.project_changes.entries : "${e.timestamp} ${e.actor} ${e.action}"
+  ]
+}
@@ -0,0 +1,19 @@
+# Validate automation rule triggers against the values supported by the Wiz API
+data "wiz_automation_triggers" "available" {}
+
+variable "trigger_source" {
+  type    = string
+  default = "ISSUES"
+}
+
+resource "wiz_automation_rule_jira_add_comment" "example" {
+  # ...
+  trigger_source = var.trigger_source
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.wiz_automation_triggers.available.trigger_sources, var.trigger_source)
+      error_message = "trigger_source must be one of ${join(", ", data.wiz_automation_triggers.available.trigger_sources)}."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+# Assert that a rule flags a known non-compliant bucket
+data "wiz_cloud_config_rule_evaluation" "unencrypted_bucket" {
+  rule_id     = wiz_cloud_config_rule.s3_encryption.id
+  resource_id = "5f1e2d3c-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+}
+
+check "s3_encryption_rule_flags_sample" {
+  assert {
+    condition     = data.wiz_cloud_config_rule_evaluation.unencrypted_bucket.result == "FAIL"
+    error_message = "The rule did not fail the unencrypted sample bucket."
+  }
+}
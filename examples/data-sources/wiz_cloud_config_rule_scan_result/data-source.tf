@@ -0,0 +1,12 @@
+# Assert that a newly authored rule is evaluating resources
+data "wiz_cloud_config_rule_scan_result" "s3_encryption" {
+  rule_id     = wiz_cloud_config_rule.s3_encryption.id
+  sample_size = 5
+}
+
+check "s3_encryption_rule_is_live" {
+  assert {
+    condition     = data.wiz_cloud_config_rule_scan_result.s3_encryption.pass_count + data.wiz_cloud_config_rule_scan_result.s3_encryption.fail_count > 0
+    error_message = "The rule has not evaluated any resources yet."
+  }
+}
@@ -0,0 +1,14 @@
+# Get the compliance posture of a framework across the tenant
+data "wiz_compliance_posture" "tenant" {
+  framework_id = "wf-id-1"
+}
+
+# Get the compliance posture of a framework for a single project
+data "wiz_compliance_posture" "project" {
+  framework_id = "wf-id-1"
+  project_id   = "ee25cc95-82b0-4543-8934-5bc655b86786"
+}
+
+output "project_score" {
+  value = data.wiz_compliance_posture.project.score_percentage
+}
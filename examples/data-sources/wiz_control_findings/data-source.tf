@@ -0,0 +1,10 @@
+# Check that a new control flags entities
+data "wiz_control_findings" "public_buckets" {
+  control_id  = wiz_control.public_buckets.id
+  severity    = "HIGH"
+  max_results = 50
+}
+
+output "flagged_entities" {
+  value = [for f in data.wiz_control_findings.public_buckets.findings : f.entity_name]
+}
@@ -0,0 +1,6 @@
+# Get the identity the provider is authenticated as
+data "wiz_current_user" "me" {}
+
+output "wiz_identity" {
+  value = "${data.wiz_current_user.me.type}/${data.wiz_current_user.me.name}"
+}
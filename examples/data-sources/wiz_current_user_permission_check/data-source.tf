@@ -0,0 +1,18 @@
+# Fail before apply when the provider identity cannot manage SAML identity providers and projects
+data "wiz_current_user_permission_check" "preflight" {
+  scopes = [
+    "read:projects",
+    "admin:identity_providers",
+    "write:projects",
+  ]
+  require = true
+}
+
+# Or report the missing scopes without failing
+data "wiz_current_user_permission_check" "report" {
+  scopes = ["create:security_scans"]
+}
+
+output "missing_scopes" {
+  value = data.wiz_current_user_permission_check.report.missing_scopes
+}
@@ -0,0 +1,8 @@
+# Get a built-in data classifier by name
+data "wiz_data_classifier" "credit_card" {
+  name = "Credit Card Number"
+}
+
+output "credit_card_classifier_id" {
+  value = data.wiz_data_classifier.credit_card.id
+}
@@ -0,0 +1,15 @@
+# Look up an entity and only create a rule when it is tagged for production
+data "wiz_entity" "bucket" {
+  id   = "b6a2a4c1-54d6-5c2a-9e8b-5d0b3c1f2a77"
+  type = "BUCKET"
+}
+
+output "bucket_owner" {
+  value = lookup(data.wiz_entity.bucket.tags, "owner", "unknown")
+}
+
+# Look up an entity by its cloud provider identifier
+data "wiz_entity" "bucket_by_arn" {
+  external_id = "arn:aws:s3:::example-bucket"
+  type        = "BUCKET"
+}
@@ -0,0 +1,32 @@
+# Read a field the provider does not model yet
+data "wiz_graphql" "tenant" {
+  query = <<-EOT
+    query tenant {
+      viewer {
+        tenant {
+          id
+          name
+        }
+      }
+    }
+  EOT
+}
+
+output "tenant_name" {
+  value = jsondecode(data.wiz_graphql.tenant.result).viewer.tenant.name
+}
+
+# Pass variables as JSON
+data "wiz_graphql" "project" {
+  query = <<-EOT
+    query project($id: ID!) {
+      project(id: $id) {
+        name
+        archived
+      }
+    }
+  EOT
+  variables = jsonencode({
+    id = "ee25cc95-82b0-4543-8934-5bc655b86786"
+  })
+}
@@ -0,0 +1,19 @@
+data "wiz_login_domains" "sso" {}
+
+locals {
+  managed_domains = toset(data.wiz_login_domains.sso.domains[*].domain)
+  new_user_email  = "jane.doe@example.com"
+}
+
+resource "wiz_user" "jane" {
+  name  = "Jane Doe"
+  email = local.new_user_email
+  role  = "GLOBAL_READER"
+
+  lifecycle {
+    precondition {
+      condition     = contains(local.managed_domains, lower(split("@", local.new_user_email)[1]))
+      error_message = "The user's email domain is not configured for SSO."
+    }
+  }
+}
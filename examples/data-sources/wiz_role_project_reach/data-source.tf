@@ -0,0 +1,11 @@
+data "wiz_role_project_reach" "project_admin" {
+  role = "Project Admin"
+}
+
+output "project_admin_projects" {
+  value = data.wiz_role_project_reach.project_admin.project_ids
+}
+
+output "project_admin_is_global" {
+  value = data.wiz_role_project_reach.project_admin.global
+}
@@ -0,0 +1,14 @@
+data "wiz_role_scope_template" "triage" {
+  name = "triage"
+
+  custom_template {
+    name      = "triage"
+    scopes    = ["update:issues", "write:issues"]
+    templates = ["read-only"]
+  }
+}
+
+resource "wiz_service_account" "triage" {
+  name   = "triage"
+  scopes = data.wiz_role_scope_template.triage.scopes
+}
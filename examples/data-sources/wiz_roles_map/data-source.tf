@@ -0,0 +1,17 @@
+data "wiz_roles_map" "all" {}
+
+locals {
+  roles = data.wiz_roles_map.all.roles
+}
+
+resource "wiz_saml_idp" "okta" {
+  name        = "okta"
+  login_url   = "https://example.okta.com/app/wiz/sso/saml"
+  certificate = file("${path.module}/okta.pem")
+
+  group_mapping {
+    provider_group_id = "platform-admins"
+    role              = local.roles["Project Admin"]
+    projects          = ["ee25cc95-82b0-4543-8934-5bc655b86786"]
+  }
+}
@@ -0,0 +1,9 @@
+# Review the effective permissions of a SAML group
+data "wiz_saml_group_effective_permissions" "engineering" {
+  saml_idp_id       = wiz_saml_idp.example.id
+  provider_group_id = "engineering"
+}
+
+output "engineering_scopes" {
+  value = data.wiz_saml_group_effective_permissions.engineering.scopes
+}
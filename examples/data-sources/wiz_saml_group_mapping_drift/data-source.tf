@@ -0,0 +1,21 @@
+# Report which of the mappings of a manifest have drifted in Wiz
+data "wiz_saml_group_mapping_manifest" "mappings" {
+  path = "${path.module}/group_mappings.csv"
+}
+
+data "wiz_saml_group_mapping_drift" "mappings" {
+  saml_idp_id = wiz_saml_idp.example.id
+
+  dynamic "expected" {
+    for_each = data.wiz_saml_group_mapping_manifest.mappings.group_mappings
+    content {
+      provider_group_id = expected.value.provider_group_id
+      role              = expected.value.role
+      projects          = expected.value.projects
+    }
+  }
+}
+
+output "drifted_mappings" {
+  value = [for d in data.wiz_saml_group_mapping_drift.mappings.drift : d if d.status != "in_sync"]
+}
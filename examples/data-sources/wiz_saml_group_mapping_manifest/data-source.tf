@@ -0,0 +1,23 @@
+# Read group mappings from a manifest, e.g. group_mappings.csv:
+#
+# provider_group_id,role,projects
+# global.admin,GLOBAL_ADMIN,
+# team-a,PROJECT_ADMIN,slug:team-a;slug:team-a-dev
+data "wiz_saml_group_mapping_manifest" "mappings" {
+  path = "${path.module}/group_mappings.csv"
+}
+
+resource "wiz_saml_idp" "okta" {
+  name        = "okta"
+  login_url   = "https://example.okta.com/app/wiz/sso/saml"
+  certificate = file("${path.module}/okta.pem")
+
+  dynamic "group_mapping" {
+    for_each = { for m in data.wiz_saml_group_mapping_manifest.mappings.group_mappings : m.key => m }
+    content {
+      provider_group_id = group_mapping.value.provider_group_id
+      role              = group_mapping.value.role
+      projects          = group_mapping.value.projects
+    }
+  }
+}
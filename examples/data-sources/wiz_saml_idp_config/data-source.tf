@@ -0,0 +1,13 @@
+data "wiz_saml_idp_config" "okta" {
+  saml_idp_id = "okta-id"
+}
+
+# write the generated resource and import blocks to a file, to copy into the configuration that will manage the identity provider
+resource "local_file" "okta" {
+  filename = "${path.module}/generated/saml_idp_okta.tf"
+  content  = data.wiz_saml_idp_config.okta.hcl
+}
+
+output "import_command" {
+  value = data.wiz_saml_idp_config.okta.import_command
+}
@@ -0,0 +1,16 @@
+# Reuse a saved security graph query in a control
+data "wiz_saved_query" "public_vms" {
+  name = "Public VMs"
+}
+
+resource "wiz_control" "public_vms" {
+  name        = "Public VMs"
+  description = "Virtual machines exposed to the internet"
+  severity    = "HIGH"
+  query       = data.wiz_saved_query.public_vms.query
+  scope_query = jsonencode({
+    "type" : [
+      "SUBSCRIPTION"
+    ]
+  })
+}
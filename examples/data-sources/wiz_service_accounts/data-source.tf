@@ -0,0 +1,4 @@
+# Find all service accounts that can administer users
+data "wiz_service_accounts" "user_admins" {
+  has_scope = "admin:users"
+}
@@ -0,0 +1,6 @@
+# Fail fast when the provider cannot authenticate or reach the Wiz API
+data "wiz_service_status" "preflight" {}
+
+output "wiz_tenant_id" {
+  value = data.wiz_service_status.preflight.tenant_id
+}
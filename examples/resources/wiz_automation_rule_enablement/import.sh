@@ -0,0 +1 @@
+terraform import wiz_automation_rule_enablement.example "4c6c2c61-1b6e-4f0e-9b1e-3f4a0b4a2c11"
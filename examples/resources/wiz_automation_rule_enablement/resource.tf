@@ -0,0 +1,5 @@
+# Control only the enabled state of an automation rule managed elsewhere
+resource "wiz_automation_rule_enablement" "example" {
+  rule_id = "4c6c2c61-1b6e-4f0e-9b1e-3f4a0b4a2c11"
+  enabled = false
+}
@@ -0,0 +1,10 @@
+# Disable a set of cloud configuration rules in this environment
+data "wiz_cloud_config_rules" "aws_s3" {
+  search         = "S3"
+  cloud_provider = ["AWS"]
+}
+
+resource "wiz_cloud_config_rule_state" "disabled" {
+  rule_ids = [for rule in data.wiz_cloud_config_rules.aws_s3.cloud_configuration_rules : rule.id]
+  enabled  = false
+}
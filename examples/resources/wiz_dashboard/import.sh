@@ -0,0 +1 @@
+terraform import wiz_dashboard.platform "7d3c2b1a-0f9e-4d8c-b7a6-5e4f3d2c1b0a"
@@ -0,0 +1,14 @@
+resource "wiz_dashboard" "platform" {
+  name   = "Platform team overview"
+  shared = true
+  widgets = jsonencode([
+    {
+      type     = "ISSUES_COUNT"
+      position = { x = 0, y = 0, w = 6, h = 4 }
+    },
+    {
+      type     = "RESOURCES_COUNT"
+      position = { x = 6, y = 0, w = 6, h = 4 }
+    },
+  ])
+}
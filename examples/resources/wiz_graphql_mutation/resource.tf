@@ -0,0 +1,24 @@
+# Run a mutation the provider does not model yet, and undo it on destroy
+resource "wiz_graphql_mutation" "tag" {
+  create_query = <<-EOT
+    mutation AddTag($id: ID!, $key: String!, $value: String!) {
+      addResourceTag(input: {id: $id, key: $key, value: $value}) {
+        _stub
+      }
+    }
+  EOT
+
+  delete_query = <<-EOT
+    mutation RemoveTag($id: ID!, $key: String!) {
+      removeResourceTag(input: {id: $id, key: $key}) {
+        _stub
+      }
+    }
+  EOT
+
+  variables = jsonencode({
+    id    = "ee25cc95-82b0-4543-8934-5bc655b86786"
+    key   = "owner"
+    value = "platform"
+  })
+}
@@ -0,0 +1 @@
+terraform import wiz_notification_rule.example "2f0a6a3c-6d0e-4c1b-a3e5-8f4d2b7c9e10"
@@ -0,0 +1,8 @@
+resource "wiz_notification_rule" "critical_issues" {
+  name       = "critical-issues-digest"
+  channel    = "EMAIL"
+  recipients = ["security@example.com"]
+  filters = jsonencode({
+    severity = ["CRITICAL"]
+  })
+}
@@ -0,0 +1 @@
+terraform import wiz_outpost.example "7a5b4f4a-8a66-4b8b-9a0b-8b0e3f6d8c21"
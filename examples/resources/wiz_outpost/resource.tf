@@ -0,0 +1,8 @@
+resource "wiz_outpost" "example" {
+  name         = "scanning-outpost"
+  service_type = "AWS"
+  config = jsonencode({
+    accountId = "123456789012"
+    region    = "us-east-1"
+  })
+}
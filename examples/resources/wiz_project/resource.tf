@@ -8,6 +8,15 @@ resource "wiz_project" "test" {
   business_unit = "Technology"
 }
 
+# Permanently delete the project on destroy instead of archiving it
+resource "wiz_project" "ephemeral" {
+  name            = "Ephemeral App"
+  delete_behavior = "delete"
+  risk_profile {
+    business_impact = "LBI"
+  }
+}
+
 # Folder projects example
 resource "wiz_project" "root" {
   name        = "root"
@@ -0,0 +1,14 @@
+locals {
+  environments = ["dev", "test", "staging", "prod"]
+}
+
+resource "wiz_projects" "environments" {
+  dynamic "project" {
+    for_each = local.environments
+    content {
+      key           = project.value
+      name          = "payments-${project.value}"
+      business_unit = "Payments"
+    }
+  }
+}
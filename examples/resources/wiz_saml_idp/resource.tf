@@ -55,6 +55,7 @@ EOT
     role              = "PROJECT_READER"
     projects = [
       "e7f6542c-81f6-43cf-af48-bdd77f09650d",
+      "slug:my-project",
     ]
   }
 
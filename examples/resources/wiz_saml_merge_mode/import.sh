@@ -0,0 +1 @@
+terraform import wiz_saml_merge_mode.example "SSO-Example"
@@ -0,0 +1,5 @@
+# Control only the group mapping merge mode of a SAML identity provider managed elsewhere
+resource "wiz_saml_merge_mode" "example" {
+  saml_idp_id   = "SSO-Example"
+  merge_enabled = true
+}
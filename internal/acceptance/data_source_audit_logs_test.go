@@ -0,0 +1,37 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceWizAuditLogs_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceWizAuditLogsBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.wiz_audit_logs.foo",
+						"id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.wiz_audit_logs.foo",
+						"max_results",
+						"5",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceWizAuditLogsBasic = `
+data "wiz_audit_logs" "foo" {
+  since       = timeadd(plantimestamp(), "-24h")
+  max_results = 5
+}
+`
@@ -0,0 +1,62 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatasourceWizCompliancePosture_basic reads the posture of a newly created framework
+// a framework without evaluated resources is expected to return zero counts
+func TestAccDatasourceWizCompliancePosture_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceWizCompliancePostureBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wiz_compliance_posture.foo",
+						"passed_count",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"data.wiz_compliance_posture.foo",
+						"failed_count",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"data.wiz_compliance_posture.foo",
+						"score_percentage",
+						"0",
+					),
+					resource.TestCheckResourceAttr(
+						"data.wiz_compliance_posture.foo",
+						"categories.#",
+						"1",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceWizCompliancePostureBasic = `
+resource "wiz_security_framework" "foo" {
+  name        = "test-acc-WizCompliancePosture_basic"
+  description = "Terraform provider acceptance test TestAccDatasourceWizCompliancePosture_basic"
+  enabled     = true
+  category {
+    name        = "test category"
+    description = "test description"
+    sub_category {
+      title = "test subcategory"
+    }
+  }
+}
+
+data "wiz_compliance_posture" "foo" {
+  framework_id = wiz_security_framework.foo.id
+}
+`
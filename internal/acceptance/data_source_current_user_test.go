@@ -0,0 +1,37 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatasourceWizCurrentUser_basic expects the acceptance tests to run as a service account
+func TestAccDatasourceWizCurrentUser_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceWizCurrentUserBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wiz_current_user.foo",
+						"type",
+						"SERVICE_ACCOUNT",
+					),
+					resource.TestMatchResourceAttr(
+						"data.wiz_current_user.foo",
+						"scopes.#",
+						regexp.MustCompile(`^[1-9][0-9]*$`),
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceWizCurrentUserBasic = `
+data "wiz_current_user" "foo" {}
+`
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatasourceWizServiceAccounts_basic creates a service account with a distinct scope and looks it up by that scope
+func TestAccDatasourceWizServiceAccounts_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceWizServiceAccountsBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.wiz_service_accounts.foo",
+						"service_accounts.*",
+						map[string]string{
+							"name": "test-acc-WizServiceAccounts_basic",
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceWizServiceAccountsBasic = `
+resource "wiz_service_account" "foo" {
+  name = "test-acc-WizServiceAccounts_basic"
+  scopes = [
+    "read:security_scans",
+  ]
+}
+
+data "wiz_service_accounts" "foo" {
+  has_scope = "read:security_scans"
+
+  depends_on = [wiz_service_account.foo]
+}
+`
@@ -0,0 +1,36 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceWizServiceStatus_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceWizServiceStatusBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.wiz_service_status.foo",
+						"authenticated",
+						"true",
+					),
+					resource.TestMatchResourceAttr(
+						"data.wiz_service_status.foo",
+						"tenant_id",
+						regexp.MustCompile(UUIDPattern),
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceWizServiceStatusBasic = `
+data "wiz_service_status" "foo" {}
+`
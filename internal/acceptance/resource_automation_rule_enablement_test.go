@@ -0,0 +1,88 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizAutomationRuleEnablement_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWizAutomationRuleEnablementBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"wiz_automation_rule_aws_sns.foo",
+						"id",
+						"wiz_automation_rule_enablement.foo",
+						"rule_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_enablement.foo",
+						"enabled",
+						"true",
+					),
+				),
+			},
+			{
+				Config: testAccResourceWizAutomationRuleEnablementBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_automation_rule_enablement.foo",
+						"enabled",
+						"false",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_automation_rule_enablement.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceWizAutomationRuleEnablementBasic(enabled bool) string {
+	return fmt.Sprintf(`
+resource "wiz_integration_aws_sns" "foo" {
+  name                      = "test-acc-WizAutomationRuleEnablement_basic"
+  aws_sns_topic_arn         = "arn:aws:sns:us-east-1:123456789012:Wiz"
+  aws_sns_access_method     = "ASSUME_SPECIFIED_ROLE"
+  aws_sns_customer_role_arn = "arn:aws:iam::123456789012:role/Wiz"
+  scope                     = "All Resources, Restrict this Integration to global roles only"
+}
+
+resource "wiz_automation_rule_aws_sns" "foo" {
+  name           = "test-acc-WizAutomationRuleEnablement_basic"
+  description    = "Terraform provider acceptance test TestAccResourceWizAutomationRuleEnablement_basic"
+  enabled        = false
+  integration_id = wiz_integration_aws_sns.foo.id
+  trigger_source = "ISSUES"
+  trigger_type = [
+    "CREATED",
+  ]
+  aws_sns_body = jsonencode({
+    "issue" : {
+      "id" : "{{issue.id}}"
+    }
+  })
+  filters = jsonencode({
+    "project" : []
+  })
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
+
+resource "wiz_automation_rule_enablement" "foo" {
+  rule_id = wiz_automation_rule_aws_sns.foo.id
+  enabled = %t
+}
+`, enabled)
+}
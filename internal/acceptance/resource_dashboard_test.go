@@ -0,0 +1,65 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizDashboard_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizDashboardBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_dashboard.test",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_dashboard.test",
+						"shared",
+						"false",
+					),
+				),
+			},
+			{
+				Config: testResourceWizDashboardBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_dashboard.test",
+						"shared",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_dashboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizDashboardBasic(rName string, shared bool) string {
+	return fmt.Sprintf(`
+resource "wiz_dashboard" "test" {
+  name   = "%s"
+  shared = %t
+  widgets = jsonencode([
+    {
+      type     = "ISSUES_COUNT"
+      position = { x = 0, y = 0, w = 6, h = 4 }
+    },
+  ])
+}
+`, rName, shared)
+}
@@ -0,0 +1,70 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizNotificationRule_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizNotificationRuleBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_notification_rule.test",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_notification_rule.test",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_notification_rule.test",
+						"recipients.#",
+						"1",
+					),
+				),
+			},
+			{
+				Config: testResourceWizNotificationRuleBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_notification_rule.test",
+						"enabled",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:            "wiz_notification_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_request_id"},
+			},
+		},
+	})
+}
+
+func testResourceWizNotificationRuleBasic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "wiz_notification_rule" "test" {
+  name       = "%s"
+  enabled    = %t
+  channel    = "EMAIL"
+  recipients = ["security@example.com"]
+  filters = jsonencode({
+    severity = ["CRITICAL"]
+  })
+}
+`, rName, enabled)
+}
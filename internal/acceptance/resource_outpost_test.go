@@ -0,0 +1,68 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizOutpost_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizOutpostBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_outpost.test",
+						"name",
+						rName,
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_outpost.test",
+						"enabled",
+						"false",
+					),
+					resource.TestCheckResourceAttrSet(
+						"wiz_outpost.test",
+						"status",
+					),
+				),
+			},
+			{
+				Config: testResourceWizOutpostBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_outpost.test",
+						"enabled",
+						"true",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_outpost.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizOutpostBasic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "wiz_outpost" "test" {
+  name         = "%s"
+  service_type = "AWS"
+  enabled      = %t
+  config = jsonencode({
+    accountId = "123456789012"
+    region    = "us-east-1"
+  })
+}
+`, rName, enabled)
+}
@@ -0,0 +1,68 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizProjects_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcProject)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizProjectsBasic(rName, "dev", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_projects.test",
+						"project_ids.%",
+						"2",
+					),
+					resource.TestCheckResourceAttrSet(
+						"wiz_projects.test",
+						"project_ids.dev",
+					),
+				),
+			},
+			{
+				Config: testResourceWizProjectsBasic(rName, "dev", "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_projects.test",
+						"project_ids.%",
+						"2",
+					),
+					resource.TestCheckNoResourceAttr(
+						"wiz_projects.test",
+						"project_ids.test",
+					),
+					resource.TestCheckResourceAttrSet(
+						"wiz_projects.test",
+						"project_ids.prod",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testResourceWizProjectsBasic(rName string, first string, second string) string {
+	return fmt.Sprintf(`
+resource "wiz_projects" "test" {
+  project {
+    key  = "%[2]s"
+    name = "%[1]s-%[2]s"
+  }
+  project {
+    key           = "%[3]s"
+    name          = "%[1]s-%[3]s"
+    business_unit = "Engineering"
+  }
+}
+`, rName, first, second)
+}
@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceWizSAMLMergeMode_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceWizSAMLMergeModeBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"wiz_saml_idp.test",
+						"id",
+						"wiz_saml_merge_mode.test",
+						"saml_idp_id",
+					),
+					resource.TestCheckResourceAttr(
+						"wiz_saml_merge_mode.test",
+						"merge_enabled",
+						"true",
+					),
+				),
+			},
+			{
+				Config: testResourceWizSAMLMergeModeBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"wiz_saml_merge_mode.test",
+						"merge_enabled",
+						"false",
+					),
+				),
+			},
+			{
+				ResourceName:      "wiz_saml_merge_mode.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceWizSAMLMergeModeBasic(rName string, mergeEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "wiz_saml_merge_mode" "test" {
+  saml_idp_id   = wiz_saml_idp.test.id
+  merge_enabled = %t
+}
+`, testResourceWizSAMLIdpIgnoreMergeMode(rName), mergeEnabled)
+}
+
+// testResourceWizSAMLIdpIgnoreMergeMode hands ownership of the merge mode to wiz_saml_merge_mode
+func testResourceWizSAMLIdpIgnoreMergeMode(rName string) string {
+	config := testResourceWizSAMLIdpBasic(rName)
+	return config[:len(config)-len("}\n")] + `
+  lifecycle {
+    ignore_changes = [merge_groups_mapping_by_role]
+  }
+}
+`
+}
@@ -97,6 +97,32 @@ func TestAccResourceWizServiceAccount_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceWizServiceAccount_globalTypeRejectsProjects(t *testing.T) {
+	rName := acctest.RandomWithPrefix(ResourcePrefix)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t, TestCase(TcCommon)) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceWizServiceAccountGlobalWithProjects(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'assigned_projects' can only be set for THIRD_PARTY service accounts`),
+			},
+		},
+	})
+}
+
+func testResourceWizServiceAccountGlobalWithProjects(rName string) string {
+	return fmt.Sprintf(`
+		resource "wiz_service_account" "test" {
+			name              = "%s"
+			type              = "BROKER"
+			assigned_projects = [ "%s" ]
+		  }
+	`, rName, uuid.New().String())
+}
+
 func testResourceWizServiceAccountBasic(rName string, rType string) string {
 	switch rType {
 	// THIRD_PARTY service accounts require scopes and can accept assigned_projects
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+// readByIDQuery matches queries that read a single object by id, e.g. query x ($id: ID!){ field(id: $id) { ... } }
+var readByIDQuery = regexp.MustCompile(`^\s*query\s*\w*\s*\(\s*\$id\s*:\s*ID!\s*\)\s*\{\s*(\w+)\s*\(\s*id\s*:\s*\$id\s*\)\s*(\{[\s\S]*\})\s*\}\s*$`)
+
+// ProcessBatchedReadRequest func - process a read by id, batching it with concurrent reads of the same shape
+// when read batching is enabled, reads issued within a short window are sent as one request with each read under its own alias.
+// queries that do not read a single object by id, and reads whose batch fails, are processed individually with ProcessRequest.
+func ProcessBatchedReadRequest(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "client.ProcessBatchedReadRequest called...")
+
+	batcher := m.(*config.ProviderConf).ReadBatcher
+	queryVars, ok := vars.(*internal.QueryVariables)
+	match := readByIDQuery.FindStringSubmatch(query)
+	if batcher == nil || !ok || queryVars.ID == "" || match == nil {
+		return ProcessRequest(ctx, m, vars, data, query, resourceType, "read")
+	}
+	field, selection := match[1], match[2]
+
+	// join a batch, the first read of a batch waits for others to join and sends it
+	key := fmt.Sprintf("%s\n%s\n%s", resourceType, field, selection)
+	read := &config.BatchedRead{ID: queryVars.ID}
+	batch, leader := batcher.Join(key, read)
+	if leader {
+		select {
+		case <-ctx.Done():
+		case <-time.After(batcher.Window()):
+		}
+		processReadBatch(ctx, m, field, selection, resourceType, batcher.Seal(key, batch))
+		close(batch.Done)
+	} else {
+		select {
+		case <-ctx.Done():
+			return append(diags, diag.FromErr(ctx.Err())...)
+		case <-batch.Done:
+		}
+	}
+
+	// fall back to an individual request so errors are reported for the right object
+	if len(read.Result) == 0 || string(read.Result) == "null" {
+		tflog.Debug(ctx, fmt.Sprintf("%s read of %s not resolved by batch, reading individually", resourceType, read.ID))
+		return ProcessRequest(ctx, m, vars, data, query, resourceType, "read")
+	}
+
+	// decode the aliased result as if it were returned by the original query
+	body, err := json.Marshal(map[string]json.RawMessage{field: read.Result})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = json.Unmarshal(body, data)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// processReadBatch sends the reads of a batch as a single aliased query and stores each result on its read
+func processReadBatch(ctx context.Context, m interface{}, field, selection, resourceType string, reads []*config.BatchedRead) {
+	tflog.Info(ctx, "client.processReadBatch called...")
+	tflog.Debug(ctx, fmt.Sprintf("Batching %d %s reads", len(reads), resourceType))
+
+	// define the graphql query, one aliased field per read
+	var params, fields []string
+	vars := make(map[string]string)
+	for i, read := range reads {
+		params = append(params, fmt.Sprintf("$id%d: ID!", i))
+		fields = append(fields, fmt.Sprintf("r%d: %s(id: $id%d) %s", i, field, i, selection))
+		vars[fmt.Sprintf("id%d", i)] = read.ID
+	}
+	query := fmt.Sprintf("query batchedRead (%s){\n%s\n}", strings.Join(params, ", "), strings.Join(fields, "\n"))
+
+	// process the request, a failed batch leaves every result empty
+	data := make(map[string]json.RawMessage)
+	diags := ProcessRequest(ctx, m, vars, &data, query, resourceType, "read")
+	if len(diags) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Batched %s read reported errors, reads will be retried individually", resourceType))
+		return
+	}
+	for i, read := range reads {
+		read.Result = data[fmt.Sprintf("r%d", i)]
+	}
+}
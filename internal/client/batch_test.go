@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+func TestProcessBatchedReadRequest(t *testing.T) {
+	// Mock data
+	mockQuery := `query samlIdentityProvider ($id: ID!){
+	    samlIdentityProvider (
+	        id: $id
+	    ) {
+	        id
+	        name
+	    }
+	}`
+	ids := []string{
+		"1f6c2d84-4a0e-4b8e-9a57-5c1e8e0b9d21",
+		"7b3e9a15-2c6d-4f8a-b0e4-3d9f1a6c8e72",
+	}
+
+	// Mock context
+	ctx := context.TODO()
+
+	// answer each aliased field with the object for its id variable
+	var requestCount int32
+	mockRoundTripper := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&requestCount, 1)
+			request := struct {
+				Variables map[string]string `json:"variables"`
+			}{}
+			err := json.NewDecoder(req.Body).Decode(&request)
+			assert.NoError(t, err)
+			data := make(map[string]interface{})
+			for name, id := range request.Variables {
+				data["r"+name[len("id"):]] = map[string]string{"id": id, "name": "idp-" + id[:8]}
+			}
+			responseBody, err := json.Marshal(map[string]interface{}{"data": data})
+			assert.NoError(t, err)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody)),
+				Header:     make(http.Header),
+			}, nil
+		},
+	}
+
+	// Mock config
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: mockRoundTripper,
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		UserAgent:   "Test User Agent",
+		TokenType:   "Bearer",
+		Token:       "testtoken",
+		ReadBatcher: config.NewReadBatcher(config.ReadBatchWindow),
+	}
+
+	type samlIdentityProvider struct {
+		SAMLIdentityProvider struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"samlIdentityProvider"`
+	}
+
+	// two resources refreshed at the same time are read with one http call
+	results := make([]*samlIdentityProvider, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			vars := &internal.QueryVariables{}
+			vars.ID = id
+			results[i] = &samlIdentityProvider{}
+			diags := ProcessBatchedReadRequest(ctx, mockProviderConf, vars, results[i], mockQuery, "saml_idp")
+			assert.Empty(t, diags)
+		}(i, id)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requestCount)
+	for i, id := range ids {
+		assert.Equal(t, id, results[i].SAMLIdentityProvider.ID)
+		assert.Equal(t, "idp-"+id[:8], results[i].SAMLIdentityProvider.Name)
+	}
+
+	// without a batcher every read is sent on its own
+	mockProviderConf.ReadBatcher = nil
+	requestCount = 0
+	for _, id := range ids {
+		vars := &internal.QueryVariables{}
+		vars.ID = id
+		diags := ProcessBatchedReadRequest(ctx, mockProviderConf, vars, &samlIdentityProvider{}, mockQuery, "saml_idp")
+		assert.Empty(t, diags)
+	}
+	assert.Equal(t, int32(2), requestCount)
+}
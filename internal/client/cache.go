@@ -0,0 +1,40 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// cacheableQueries maps the resource types whose read queries are static within an apply to their cache group
+// only catalogs that no resource of the provider modifies belong here, a cached read of a managed object would be stale after an update
+// a create, update or delete for any resource type in a group invalidates the cached responses for that group
+// the roles query also returns the permission scopes of each role, so it serves as the scope catalog as well
+var cacheableQueries = map[string]string{
+	"roles": "roles",
+}
+
+// encodeCachedPages returns the pages of a paged read in the form stored in the query cache
+func encodeCachedPages(pages []interface{}) ([]byte, error) {
+	return json.Marshal(pages)
+}
+
+// decodeCachedPages returns the pages stored in the query cache as new values of the type of data
+func decodeCachedPages(body []byte, data interface{}) ([]interface{}, error) {
+	var raw []json.RawMessage
+	err := json.Unmarshal(body, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode cached pages: %w", err)
+	}
+
+	pages := make([]interface{}, 0, len(raw))
+	for _, r := range raw {
+		page := reflect.New(reflect.TypeOf(data).Elem()).Interface()
+		err = json.Unmarshal(r, page)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode cached pages: %w", err)
+		}
+		pages = append(pages, page)
+	}
+	return pages, nil
+}
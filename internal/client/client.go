@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
+	"os"
 	"reflect"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +23,18 @@ import (
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
+// TenantHeader selects the tenant for service accounts that span multiple tenants
+const TenantHeader = "X-Wiz-Tenant"
+
+// RequestIDHeader identifies a request in the Wiz API logs, support asks for it when investigating a call
+const RequestIDHeader = "X-Request-Id"
+
+// graphQLMutation matches a graphql document whose operation is a mutation, allowing for leading comments
+var graphQLMutation = regexp.MustCompile(`^\s*(?:#[^\n]*\s*)*mutation\b`)
+
+// graphQLOperationName matches the operation name of a graphql query
+var graphQLOperationName = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
 // GraphQLRequest struct
 type GraphQLRequest struct {
 	Query     string      `json:"query"`
@@ -46,18 +63,40 @@ type MutationInput struct {
 
 // ProcessRequest func - process the unpaginated request
 func ProcessRequest(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType, operation string) (diags diag.Diagnostics) {
+	return processRequest(ctx, m, vars, data, query, resourceType, operation, false, nil)
+}
+
+// ProcessRawRequest func - process an unpaginated request whose variables are sent as given, for any operation
+// a raw mutation can change any object, so it invalidates every cached query
+func ProcessRawRequest(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType, operation string) (diags diag.Diagnostics) {
+	return processRequest(ctx, m, vars, data, query, resourceType, operation, true, nil)
+}
+
+// ProcessRequestWithRequestID func - process the unpaginated request and return the request id reported by the api
+// the request id is returned whenever the api answered, including when it reported errors
+func ProcessRequestWithRequestID(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType, operation string) (requestID string, diags diag.Diagnostics) {
+	diags = processRequest(ctx, m, vars, data, query, resourceType, operation, false, &requestID)
+	return requestID, diags
+}
+
+func processRequest(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType, operation string, raw bool, requestID *string) (diags diag.Diagnostics) {
 	tflog.Info(ctx, "client.ProcessRequest called...")
 	tflog.Debug(ctx, fmt.Sprintf("Received vars: %T, %s", vars, utils.PrettyPrint(vars)))
 	tflog.Debug(ctx, fmt.Sprintf("Received query: %T, %s", query, query))
 	tflog.Debug(ctx, fmt.Sprintf("Received resourceType/operation: %s %s", resourceType, operation))
 
+	// refuse mutations in read-only mode
+	if readOnlyDiags := readOnlyDiagnostics(m, query, resourceType, operation); readOnlyDiags != nil {
+		return append(diags, readOnlyDiags...)
+	}
+
 	// get an http client
 	client := m.(*config.ProviderConf).HTTPClient
 
 	// encode the request body (graphql query and variables)
 	b := new(bytes.Buffer)
-	switch op := operation; op {
-	case "read":
+	switch op := operation; {
+	case op == "read" || raw:
 		err := json.NewEncoder(b).Encode(GraphQLRequest{Query: query, Variables: vars})
 		if err != nil {
 			return append(diags, diag.FromErr(err)...)
@@ -73,33 +112,70 @@ func ProcessRequest(ctx context.Context, m interface{}, vars, data interface{},
 		tflog.Debug(ctx, fmt.Sprintf("%s %s request variables: %s", resourceType, operation, utils.PrettyPrint(input)))
 	}
 
+	// serve static queries from the cache, and invalidate the cache on mutations
+	cache := m.(*config.ProviderConf).QueryCache
+	cacheGroup, cacheable := cacheableQueries[resourceType]
+	cacheKey := fmt.Sprintf("%s\n%s", resourceType, b.String())
+	if cache != nil && raw && operation != "read" {
+		tflog.Debug(ctx, "Invalidating the query cache")
+		cache.Clear()
+	} else if cache != nil && cacheable {
+		if operation != "read" {
+			tflog.Debug(ctx, fmt.Sprintf("Invalidating query cache group %s", cacheGroup))
+			cache.Invalidate(cacheGroup)
+		} else if cached, ok := cache.Get(cacheKey); ok {
+			tflog.Debug(ctx, fmt.Sprintf("%s %s served from query cache", resourceType, operation))
+			err := json.Unmarshal(cached, &MutationPayload{Data: data})
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+
 	// create the http request, set the user agent, setup the authentication token, log the request
+	sent := int64(b.Len())
 	request, error, diags := CreateRequest(ctx, m, b, diags, resourceType, operation)
 	if error {
 		return diags
 	}
 
 	// call the api
+	request, cancel := withRequestTimeout(ctx, m, request, query, operation)
+	defer cancel()
+	start := time.Now()
 	resp, err := client.Do(request)
+	latency := time.Since(start)
 	if err != nil {
+		recordRequestStats(ctx, m.(*config.ProviderConf).Stats, resourceType, operation, latency, sent, 0)
+		if timeoutDiags := requestTimeoutDiags(m, request, query, resourceType, operation); timeoutDiags != nil {
+			return append(diags, timeoutDiags...)
+		}
 		return append(diags, diag.FromErr(err)...)
 	}
 	defer resp.Body.Close()
 
+	// record the request id for the caller
+	responseRequestID := resp.Header.Get(RequestIDHeader)
+	if requestID != nil {
+		*requestID = responseRequestID
+	}
+
 	// log the response
 	respDump, err := httputil.DumpResponse(resp, true)
+	recordRequestStats(ctx, m.(*config.ProviderConf).Stats, resourceType, operation, latency, sent, int64(len(respDump)))
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
-	tflog.Debug(ctx, fmt.Sprintf("%s %s api response: %s", resourceType, operation, respDump))
+	tflog.Debug(ctx, fmt.Sprintf("%s %s api response: %s", resourceType, operation, utils.RedactHTTPDump(respDump)))
 
 	// handle http errors
 	if resp.StatusCode != http.StatusOK {
-		return append(diags, diag.Diagnostic{
+		return append(diags, withRequestDetail(m, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("HTTP Response (%d)", resp.StatusCode),
-			Detail:   fmt.Sprintf("Response: %s", respDump),
-		})
+			Detail:   fmt.Sprintf("Response: %s", utils.RedactHTTPDump(respDump)),
+		}, query, vars, responseRequestID))
 	}
 
 	// read the response
@@ -120,11 +196,24 @@ func ProcessRequest(ctx context.Context, m interface{}, vars, data interface{},
 	tflog.Debug(ctx, fmt.Sprintf("Error count: %d", errorCount))
 	if errorCount > 0 {
 		tflog.Debug(ctx, fmt.Sprintf("Errors returned from API (%d)", errorCount))
-		return append(diags, diag.Diagnostic{
+		return append(diags, withRequestDetail(m, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("%s %s reported errors", resourceType, operation),
 			Detail:   fmt.Sprintf("Response: %s", utils.PrettyPrint(responseBody.Errors)),
-		})
+		}, query, vars, responseRequestID))
+	}
+
+	// reject response fields the provider does not model
+	if m.(*config.ProviderConf).Settings.StrictResponseDecoding {
+		err = strictDecodeData(rbody, data)
+		if err != nil {
+			return append(diags, withRequestDetail(m, strictDecodingDiagnostic(resourceType, operation, err), query, vars, responseRequestID))
+		}
+	}
+
+	// cache the response for static queries
+	if cache != nil && cacheable && operation == "read" {
+		cache.Set(cacheKey, cacheGroup, rbody)
 	}
 
 	// log the return data
@@ -142,6 +231,11 @@ func ProcessPagedRequest(ctx context.Context, m interface{}, vars interface{}, d
 	tflog.Debug(ctx, fmt.Sprintf("Received resourceType/operation: %s %s", resourceType, operation))
 	tflog.Debug(ctx, fmt.Sprintf("Received maxPages: %d", maxPages))
 
+	// refuse mutations in read-only mode
+	if readOnlyDiags := readOnlyDiagnostics(m, query, resourceType, operation); readOnlyDiags != nil {
+		return append(diags, readOnlyDiags...), nil
+	}
+
 	// get an http client
 	client := m.(*config.ProviderConf).HTTPClient
 
@@ -158,6 +252,21 @@ func ProcessPagedRequest(ctx context.Context, m interface{}, vars interface{}, d
 		return append(diags, diag.FromErr(fmt.Errorf("operation %s not supported for paged operations", operation))...), nil
 	}
 
+	// serve static queries from the cache, all pages of a read are cached together
+	cache := m.(*config.ProviderConf).QueryCache
+	cacheGroup, cacheable := cacheableQueries[resourceType]
+	cacheKey := fmt.Sprintf("%s\n%d\n%s", resourceType, maxPages, b.String())
+	if cache != nil && cacheable {
+		if cached, ok := cache.Get(cacheKey); ok {
+			allData, err := decodeCachedPages(cached, data)
+			if err == nil {
+				tflog.Debug(ctx, fmt.Sprintf("%s %s served from query cache", resourceType, operation))
+				return diags, allData
+			}
+			tflog.Debug(ctx, err.Error())
+		}
+	}
+
 	// create the http request, set the user agent, setup the authentication token, log the request
 	request, error, diags := CreateRequest(ctx, m, b, diags, resourceType, operation)
 	if error {
@@ -192,9 +301,15 @@ func ProcessPagedRequest(ctx context.Context, m interface{}, vars interface{}, d
 				return diags, nil
 			}
 			// make the request and handle the response
-			error, diags, continuePaging, newEndCursor := RequestDo(ctx, client, request, diags, resourceType, operation, data, &allData)
+			request, cancel := withRequestTimeout(ctx, m, request, query, operation)
+			error, diags, continuePaging, newEndCursor := requestDo(ctx, client, request, diags, resourceType, operation, data, &allData, m.(*config.ProviderConf).Settings.StrictResponseDecoding, m.(*config.ProviderConf).Stats)
+			timeoutDiags := requestTimeoutDiags(m, request, query, resourceType, operation)
+			cancel()
+			if timeoutDiags != nil {
+				return withRequestDetails(m, timeoutDiags, query, vars), nil
+			}
 			if error {
-				return diags, nil
+				return withRequestDetails(m, diags, query, vars), nil
 			}
 
 			// update `endCursor` and `paginate`
@@ -202,9 +317,15 @@ func ProcessPagedRequest(ctx context.Context, m interface{}, vars interface{}, d
 			paginate = continuePaging
 		} else {
 			// make the initial request without `endCursor`
-			error, diags, continuePaging, newEndCursor := RequestDo(ctx, client, request, diags, resourceType, operation, data, &allData)
+			request, cancel := withRequestTimeout(ctx, m, request, query, operation)
+			error, diags, continuePaging, newEndCursor := requestDo(ctx, client, request, diags, resourceType, operation, data, &allData, m.(*config.ProviderConf).Settings.StrictResponseDecoding, m.(*config.ProviderConf).Stats)
+			timeoutDiags := requestTimeoutDiags(m, request, query, resourceType, operation)
+			cancel()
+			if timeoutDiags != nil {
+				return withRequestDetails(m, timeoutDiags, query, vars), nil
+			}
 			if error {
-				return diags, nil
+				return withRequestDetails(m, diags, query, vars), nil
 			}
 			// update `endCursor` and `paginate`
 			endCursor = newEndCursor
@@ -214,11 +335,228 @@ func ProcessPagedRequest(ctx context.Context, m interface{}, vars interface{}, d
 		if !paginate {
 			break // exit loop if there are no more pages to fetch
 		}
+
+		// guard against runaway pagination caused by pathological data or api bugs
+		if safetyCap := m.(*config.ProviderConf).Settings.MaxPages; safetyCap > 0 && currentPage >= safetyCap && (maxPages == 0 || currentPage < maxPages) {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s %s exceeded the pagination limit", resourceType, operation),
+				Detail:   fmt.Sprintf("Scanned %d pages and the api still reported more results. Raise the provider max_pages setting if this is expected.", currentPage),
+			}), nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("%s %s scanned %d pages", resourceType, operation, currentPage))
+
+	// cache the pages for static queries
+	if cache != nil && cacheable {
+		body, err := encodeCachedPages(allData)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to cache %s %s: %s", resourceType, operation, err))
+		} else {
+			cache.Set(cacheKey, cacheGroup, body)
+		}
 	}
 
 	return diags, allData
 }
 
+// strictDecodeData decodes the data of a response body into a new value of the type of data, failing on fields the type does not model
+// data itself is left unchanged
+func strictDecodeData(body []byte, data interface{}) error {
+	response := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	err := json.Unmarshal(body, &response)
+	if err != nil || len(response.Data) == 0 {
+		return err
+	}
+
+	target := reflect.New(reflect.TypeOf(data).Elem()).Interface()
+	decoder := json.NewDecoder(bytes.NewReader(response.Data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
+
+// strictDecodingDiagnostic returns the error for a response that does not match the shape expected by the provider
+func strictDecodingDiagnostic(resourceType, operation string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s %s response does not match the expected shape", resourceType, operation),
+		Detail:   fmt.Sprintf("The Wiz API returned a field the provider does not know, which can mean the tenant runs a newer API version than this provider supports. Disable strict_response_decoding to ignore unknown fields.\n\nError: %s", err),
+	}
+}
+
+// requestTimeout returns the time limit of a request and the setting it comes from, a limit of 0 is disabled
+// raw graphql documents of the wiz_graphql data source are sent as reads, so the query itself is also checked for a mutation
+func requestTimeout(settings *config.Settings, query, operation string) (time.Duration, string) {
+	if operation != "read" || graphQLMutation.MatchString(query) {
+		return time.Duration(settings.MutationTimeout) * time.Second, "mutation_timeout"
+	}
+	return time.Duration(settings.QueryTimeout) * time.Second, "query_timeout"
+}
+
+// withRequestTimeout bounds a request, including its retries, by query_timeout or mutation_timeout
+// the returned cancel func must be called once the response body is read
+func withRequestTimeout(ctx context.Context, m interface{}, request *http.Request, query, operation string) (*http.Request, context.CancelFunc) {
+	timeout, _ := requestTimeout(m.(*config.ProviderConf).Settings, query, operation)
+	if timeout <= 0 {
+		return request, func() {}
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	return request.WithContext(timeoutCtx), cancel
+}
+
+// requestTimeoutDiags returns an error naming the timeout setting when a request ran out of time, or nil
+func requestTimeoutDiags(m interface{}, request *http.Request, query, resourceType, operation string) diag.Diagnostics {
+	timeout, setting := requestTimeout(m.(*config.ProviderConf).Settings, query, operation)
+	if timeout <= 0 || !errors.Is(request.Context().Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %s timed out", resourceType, operation),
+			Detail:   fmt.Sprintf("The request did not complete within %s (%s), including retries. Raise %s if the request is expected to take longer.", setting, timeout, setting),
+		},
+	}
+}
+
+// readOnlyDiagnostics returns an error when the provider is in read-only mode and the request is a mutation
+// raw graphql documents of the wiz_graphql data source are sent as reads, so the query itself is also checked
+func readOnlyDiagnostics(m interface{}, query, resourceType, operation string) diag.Diagnostics {
+	if !m.(*config.ProviderConf).Settings.ReadOnly {
+		return nil
+	}
+	if operation == "read" && !graphQLMutation.MatchString(query) {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "Provider is in read-only mode",
+			Detail:   fmt.Sprintf("%s %s was not sent to Wiz because read_only is enabled on the provider. Disable read_only to make changes.", resourceType, operation),
+		},
+	}
+}
+
+// ErrorCodes returns the graphql error codes reported by the api in a request diagnostic
+func ErrorCodes(d diag.Diagnostic) []string {
+	index := strings.Index(d.Detail, "Response: ")
+	if index < 0 {
+		return nil
+	}
+
+	// the response errors are followed by other details when debug logging is enabled
+	var errors []struct {
+		Extensions struct {
+			Code string `json:"code"`
+		} `json:"extensions"`
+	}
+	decoder := json.NewDecoder(strings.NewReader(d.Detail[index+len("Response: "):]))
+	if decoder.Decode(&errors) != nil {
+		return nil
+	}
+
+	var codes []string
+	for _, e := range errors {
+		if e.Extensions.Code != "" {
+			codes = append(codes, e.Extensions.Code)
+		}
+	}
+	return codes
+}
+
+// debugLogging reports whether provider logs are written at debug level or lower
+func debugLogging() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+	switch strings.ToUpper(level) {
+	case "DEBUG", "TRACE", "JSON":
+		return true
+	}
+	return false
+}
+
+// withQueryDetail adds the operation name and redacted query to the detail of a failed request diagnostic
+// the query is only included when debug logging is enabled, so default diagnostics are unchanged
+func withQueryDetail(d diag.Diagnostic, query string) diag.Diagnostic {
+	if !debugLogging() {
+		return d
+	}
+	operationName := "(anonymous)"
+	if match := graphQLOperationName.FindStringSubmatch(query); match != nil {
+		operationName = match[1]
+	}
+	d.Detail = fmt.Sprintf("%s\n\nOperation: %s\nQuery: %s", d.Detail, operationName, utils.RedactGraphQLQuery(query))
+	return d
+}
+
+// recordRequestStats adds a request to the api statistics when stats_output_file is set, failures to write are logged
+func recordRequestStats(ctx context.Context, stats *config.APIStats, resourceType, operation string, latency time.Duration, sent, received int64) {
+	if stats == nil {
+		return
+	}
+	err := stats.RecordRequest(fmt.Sprintf("%s %s", resourceType, operation), latency, sent, received)
+	if err != nil {
+		tflog.Warn(ctx, err.Error())
+	}
+}
+
+// withRequestDetail adds request context to the detail of a failed request diagnostic, as set by diagnostic_detail_level
+// minimal keeps only the errors reported by the api, normal adds the request id and the query when debug logging,
+// verbose always adds the request id, query and redacted variables
+func withRequestDetail(m interface{}, d diag.Diagnostic, query string, vars interface{}, requestID string) diag.Diagnostic {
+	level := m.(*config.ProviderConf).Settings.DiagnosticDetailLevel
+	if level == "minimal" {
+		d.Detail = minimalDetail(d.Detail)
+		return d
+	}
+	if requestID != "" {
+		d.Detail = fmt.Sprintf("%s\n\nRequest ID: %s", d.Detail, requestID)
+	}
+	if level != "verbose" {
+		return withQueryDetail(d, query)
+	}
+	operationName := "(anonymous)"
+	if match := graphQLOperationName.FindStringSubmatch(query); match != nil {
+		operationName = match[1]
+	}
+	d.Detail = fmt.Sprintf("%s\n\nOperation: %s\nQuery: %s\nVariables: %s", d.Detail, operationName, utils.RedactGraphQLQuery(query), utils.RedactHTTPDump([]byte(utils.PrettyPrint(vars))))
+	return d
+}
+
+// withRequestDetails applies withRequestDetail to the errors in diags
+func withRequestDetails(m interface{}, diags diag.Diagnostics, query string, vars interface{}) diag.Diagnostics {
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			diags[i] = withRequestDetail(m, diags[i], query, vars, "")
+		}
+	}
+	return diags
+}
+
+// minimalDetail reduces a detail to the compact errors reported by the api, so ErrorCodes keeps working
+// http response dumps and other details are dropped
+func minimalDetail(detail string) string {
+	index := strings.Index(detail, "Response: ")
+	if index < 0 {
+		return ""
+	}
+	var errors json.RawMessage
+	decoder := json.NewDecoder(strings.NewReader(detail[index+len("Response: "):]))
+	if decoder.Decode(&errors) != nil {
+		return ""
+	}
+	compact := new(bytes.Buffer)
+	if json.Compact(compact, errors) != nil {
+		return ""
+	}
+	return fmt.Sprintf("Response: %s", compact)
+}
+
 // CreateRequest func - create the http request
 func CreateRequest(ctx context.Context, m interface{}, b *bytes.Buffer, diags diag.Diagnostics, resourceType string, operation string) (*http.Request, bool, diag.Diagnostics) {
 	request, err := http.NewRequest("POST", m.(*config.ProviderConf).Settings.WizURL, b)
@@ -226,43 +564,60 @@ func CreateRequest(ctx context.Context, m interface{}, b *bytes.Buffer, diags di
 		return nil, true, append(diags, diag.FromErr(err)...)
 	}
 
+	// extra headers go first, the provider headers cannot be overridden
+	for name, value := range m.(*config.ProviderConf).Settings.ExtraHeaders {
+		request.Header.Set(name, value)
+	}
 	request.Header.Set("User-Agent", m.(*config.ProviderConf).UserAgent)
 
 	authToken := fmt.Sprintf("%s %s", m.(*config.ProviderConf).TokenType, m.(*config.ProviderConf).Token)
 	request.Header.Add("Authorization", authToken)
 	request.Header.Add("Content-Type", "application/json")
+	if tenantID := m.(*config.ProviderConf).Settings.TenantID; tenantID != "" {
+		request.Header.Set(TenantHeader, tenantID)
+	}
 
 	reqDump, err := httputil.DumpRequestOut(request, true)
 	if err != nil {
 		return nil, true, append(diags, diag.FromErr(err)...)
 	}
-	tflog.Debug(ctx, fmt.Sprintf("%s %s request: %s", resourceType, operation, reqDump))
+	tflog.Debug(ctx, fmt.Sprintf("%s %s request: %s", resourceType, operation, utils.RedactHTTPDump(reqDump)))
 	return request, false, nil
 }
 
 // RequestDo func - make the http request and handle the response
 func RequestDo(ctx context.Context, client *http.Client, request *http.Request, diags diag.Diagnostics, resourceType string, operation string, data interface{}, alldata *[]interface{}) (error bool, diagnostics diag.Diagnostics, haspages bool, cursor string) {
+	return requestDo(ctx, client, request, diags, resourceType, operation, data, alldata, false, nil)
+}
+
+// requestDo makes the http request and handles the response, strict rejects response fields that data does not model
+func requestDo(ctx context.Context, client *http.Client, request *http.Request, diags diag.Diagnostics, resourceType string, operation string, data interface{}, alldata *[]interface{}, strict bool, stats *config.APIStats) (error bool, diagnostics diag.Diagnostics, haspages bool, cursor string) {
 
 	// call the api
+	sent := request.ContentLength
+	start := time.Now()
 	resp, err := client.Do(request)
+	latency := time.Since(start)
 	if err != nil {
+		recordRequestStats(ctx, stats, resourceType, operation, latency, sent, 0)
 		return true, append(diags, diag.FromErr(err)...), false, ""
 	}
 	defer resp.Body.Close()
 
 	// log the response
 	respDump, err := httputil.DumpResponse(resp, true)
+	recordRequestStats(ctx, stats, resourceType, operation, latency, sent, int64(len(respDump)))
 	if err != nil {
 		return true, append(diags, diag.FromErr(err)...), false, ""
 	}
-	tflog.Debug(ctx, fmt.Sprintf("%s %s api response: %s", resourceType, operation, respDump))
+	tflog.Debug(ctx, fmt.Sprintf("%s %s api response: %s", resourceType, operation, utils.RedactHTTPDump(respDump)))
 
 	// handle http errors
 	if resp.StatusCode != http.StatusOK {
 		return true, append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("HTTP Response (%d)", resp.StatusCode),
-			Detail:   fmt.Sprintf("Response: %s", respDump),
+			Detail:   fmt.Sprintf("Response: %s", utils.RedactHTTPDump(respDump)),
 		}), false, ""
 	}
 
@@ -295,6 +650,14 @@ func RequestDo(ctx context.Context, client *http.Client, request *http.Request,
 		}), false, ""
 	}
 
+	// reject response fields the provider does not model
+	if strict {
+		err = strictDecodeData(rbody, data)
+		if err != nil {
+			return true, append(diags, strictDecodingDiagnostic(resourceType, operation, err)), false, ""
+		}
+	}
+
 	// append the page of data to the Data slice, and set the data field in the response body to nil to avoid duplication
 	paginationDetails, err := ExtractPageInfo(responseBody.Data)
 	if err != nil {
@@ -9,9 +9,11 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
@@ -162,6 +164,115 @@ func TestCreateRequest(t *testing.T) {
 	}
 }
 
+func TestCreateRequestTenantHeader(t *testing.T) {
+	ctx := context.TODO()
+
+	conf := &config.ProviderConf{
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+	}
+
+	// without a tenant the header is not sent
+	request, isError, diagnostics := CreateRequest(ctx, conf, bytes.NewBufferString("sample body"), nil, "resourceType", "operation")
+	if isError {
+		t.Fatalf("Unexpected error flag. Diagnostics: %+v", diagnostics)
+	}
+	if _, ok := request.Header[TenantHeader]; ok {
+		t.Errorf("Unexpected %s header: %s", TenantHeader, request.Header.Get(TenantHeader))
+	}
+
+	// with a tenant the header is sent on every request
+	expectedTenant := "0e1d7a3b-5c2f-4a8e-9b6d-3f2c1a0e9d8b"
+	conf.Settings.TenantID = expectedTenant
+	request, isError, diagnostics = CreateRequest(ctx, conf, bytes.NewBufferString("sample body"), nil, "resourceType", "operation")
+	if isError {
+		t.Fatalf("Unexpected error flag. Diagnostics: %+v", diagnostics)
+	}
+	if request.Header.Get(TenantHeader) != expectedTenant {
+		t.Errorf("Unexpected %s header. Expected: %s, but got: %s", TenantHeader, expectedTenant, request.Header.Get(TenantHeader))
+	}
+}
+
+func TestCreateRequestExtraHeaders(t *testing.T) {
+	ctx := context.TODO()
+
+	conf := &config.ProviderConf{
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+			ExtraHeaders: map[string]string{
+				"X-Gateway-Route":  "wiz-us1",
+				"x-request-source": "terraform",
+			},
+		},
+		UserAgent: "Test User Agent",
+		TokenType: "Bearer",
+		Token:     "testtoken",
+	}
+
+	request, isError, diagnostics := CreateRequest(ctx, conf, bytes.NewBufferString("sample body"), nil, "resourceType", "operation")
+	if isError {
+		t.Fatalf("Unexpected error flag. Diagnostics: %+v", diagnostics)
+	}
+	expected := map[string]string{
+		"X-Gateway-Route":  "wiz-us1",
+		"X-Request-Source": "terraform",
+		"Authorization":    "Bearer testtoken",
+		"User-Agent":       "Test User Agent",
+	}
+	for name, value := range expected {
+		if request.Header.Get(name) != value {
+			t.Errorf("Unexpected %s header. Expected: %s, but got: %s", name, value, request.Header.Get(name))
+		}
+	}
+}
+
+func TestProcessRequestReadOnly(t *testing.T) {
+	ctx := context.TODO()
+
+	var requests int
+	conf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: &mockRoundTripper{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					requests++
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`{"data": {}}`)),
+						Header:     make(http.Header),
+					}, nil
+				},
+			},
+		},
+		Settings: &config.Settings{
+			WizURL:   "http://example.com",
+			ReadOnly: true,
+		},
+	}
+
+	var tests = []struct {
+		query     string
+		operation string
+		sent      bool
+	}{
+		{"query projects { projects { nodes { id } } }", "read", true},
+		{"mutation DeleteProject($input: DeleteProjectInput!) { deleteProject(input: $input) { _stub } }", "delete", false},
+		{"# raw mutation\nmutation { deleteProject(input: {id: \"1\"}) { _stub } }", "read", false},
+	}
+
+	for _, tc := range tests {
+		requests = 0
+		data := &struct{}{}
+		diags := ProcessRequest(ctx, conf, struct{}{}, data, tc.query, "project", tc.operation)
+		if diags.HasError() == tc.sent {
+			t.Fatalf("Got:\n\n%#v\n\nExpected sent=%t for %s\n", diags, tc.sent, tc.query)
+		}
+		if (requests == 1) != tc.sent {
+			t.Fatalf("Got:\n\n%#v\n\nExpected sent=%t for %s\n", requests, tc.sent, tc.query)
+		}
+	}
+}
+
 func TestProcessRequest(t *testing.T) {
 	// Mock data
 	mockVars := struct {
@@ -219,6 +330,132 @@ func TestProcessRequest(t *testing.T) {
 	// Add additional assertions as needed
 }
 
+func TestProcessRequestWithRequestID(t *testing.T) {
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: &mockRoundTripper{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					header := make(http.Header)
+					header.Set(RequestIDHeader, "a1b2c3")
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`{"data": {"field": "mock response"}}`)),
+						Header:     header,
+					}, nil
+				},
+			},
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		UserAgent: "Test User Agent",
+		TokenType: "Bearer",
+		Token:     "testtoken",
+	}
+
+	data := struct {
+		Field string `json:"field"`
+	}{}
+	requestID, diags := ProcessRequestWithRequestID(context.TODO(), mockProviderConf, struct{}{}, &data, "mutation mock { field }", "mock resource", "update")
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "a1b2c3", requestID)
+	assert.Equal(t, "mock response", data.Field)
+}
+
+func TestProcessRawRequest(t *testing.T) {
+	// Capture the request bodies that reach the api
+	var bodies []string
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: &mockRoundTripper{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					body, _ := ioutil.ReadAll(req.Body)
+					bodies = append(bodies, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`{"data": {"field": "mock response"}}`)),
+						Header:     make(http.Header),
+					}, nil
+				},
+			},
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		UserAgent:  "Test User Agent",
+		TokenType:  "Bearer",
+		Token:      "testtoken",
+		QueryCache: config.NewQueryCache(config.QueryCacheTTL),
+	}
+	mockProviderConf.QueryCache.Set("roles\nquery", "roles", []byte(`{}`))
+
+	// the variables of a raw mutation are not wrapped in an input object
+	data := struct {
+		Field string `json:"field"`
+	}{}
+	vars := map[string]interface{}{"id": "x"}
+	diags := ProcessRawRequest(context.TODO(), mockProviderConf, vars, &data, "mutation mock($id: ID!) { field }", "graphql_mutation", "create")
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "mock response", data.Field)
+	assert.Len(t, bodies, 1)
+	assert.Contains(t, bodies[0], `"variables":{"id":"x"}`)
+
+	// a raw mutation can change any object, so every cached query is dropped
+	_, cached := mockProviderConf.QueryCache.Get("roles\nquery")
+	assert.False(t, cached)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	settings := &config.Settings{
+		QueryTimeout:    120,
+		MutationTimeout: 30,
+	}
+
+	timeout, setting := requestTimeout(settings, "query mock { field }", "read")
+	assert.Equal(t, 120*time.Second, timeout)
+	assert.Equal(t, "query_timeout", setting)
+
+	timeout, setting = requestTimeout(settings, "mutation mock { field }", "update")
+	assert.Equal(t, 30*time.Second, timeout)
+	assert.Equal(t, "mutation_timeout", setting)
+
+	// raw graphql mutations are sent as reads
+	timeout, setting = requestTimeout(settings, "# comment\nmutation mock { field }", "read")
+	assert.Equal(t, 30*time.Second, timeout)
+	assert.Equal(t, "mutation_timeout", setting)
+}
+
+func TestProcessRequestMutationTimeout(t *testing.T) {
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: &mockRoundTripper{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				},
+			},
+		},
+		Settings: &config.Settings{
+			WizURL:          "http://example.com",
+			MutationTimeout: 1,
+		},
+		UserAgent: "Test User Agent",
+		TokenType: "Bearer",
+		Token:     "testtoken",
+	}
+
+	data := struct {
+		Field string `json:"field"`
+	}{}
+	diags := ProcessRequest(context.TODO(), mockProviderConf, struct{}{}, &data, "mutation mock { field }", "mock resource", "update")
+
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "mock resource update timed out", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "mutation_timeout (1s)")
+}
+
 func TestProcessPagedRequest(t *testing.T) {
 	// Mock data
 	mockVars := struct {
@@ -276,3 +513,306 @@ func TestProcessPagedRequest(t *testing.T) {
 	assert.Empty(t, diags)
 	assert.Equal(t, len(allData), 1)
 }
+
+func TestProcessPagedRequestSafetyCap(t *testing.T) {
+	ctx := context.TODO()
+
+	// the api always reports another page
+	requestCount := 0
+	mockRoundTripper := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			responseBody := []byte(fmt.Sprintf(`{"data": {"items": {"pageInfo": {"endCursor": "cursor%d", "hasNextPage": true}}}}`, requestCount))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody)),
+				Header:     make(http.Header),
+			}, nil
+		},
+	}
+
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: mockRoundTripper,
+		},
+		Settings: &config.Settings{
+			WizURL:   "http://example.com",
+			MaxPages: 5,
+		},
+	}
+
+	type items struct {
+		Items struct {
+			PageInfo wiz.PageInfo `json:"pageInfo"`
+		} `json:"items"`
+	}
+
+	// an unlimited scan stops with an error at the safety cap
+	diags, allData := ProcessPagedRequest(ctx, mockProviderConf, &internal.QueryVariables{}, &items{}, "query", "items", "read", 0)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "Scanned 5 pages")
+	assert.Nil(t, allData)
+	assert.Equal(t, 5, requestCount)
+
+	// an explicit page limit below the safety cap is honored without an error
+	requestCount = 0
+	diags, allData = ProcessPagedRequest(ctx, mockProviderConf, &internal.QueryVariables{}, &items{}, "query", "items", "read", 3)
+	assert.Empty(t, diags)
+	assert.Len(t, allData, 3)
+	assert.Equal(t, 3, requestCount)
+}
+
+func TestProcessRequestQueryCache(t *testing.T) {
+	// Mock data
+	mockQuery := "query roles { roles { id name } }"
+
+	// Mock context
+	ctx := context.TODO()
+
+	// Count the requests that reach the api
+	requestCount := 0
+	mockRoundTripper := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			responseBody := []byte(`{"data": {"roles": [{"id": "GLOBAL_ADMIN", "name": "Global Admin"}]}}`)
+			response := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody)),
+				Header:     make(http.Header),
+			}
+			return response, nil
+		},
+	}
+
+	// Mock config
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: mockRoundTripper,
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		UserAgent:  "Test User Agent",
+		TokenType:  "Bearer",
+		Token:      "testtoken",
+		QueryCache: config.NewQueryCache(config.QueryCacheTTL),
+	}
+
+	type roles struct {
+		Roles []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"roles"`
+	}
+
+	// simulate 10 resources reading the roles catalog
+	for i := 0; i < 10; i++ {
+		data := &roles{}
+		diags := ProcessRequest(ctx, mockProviderConf, struct{}{}, data, mockQuery, "roles", "read")
+		assert.Empty(t, diags)
+		assert.Len(t, data.Roles, 1)
+		assert.Equal(t, "GLOBAL_ADMIN", data.Roles[0].ID)
+	}
+	assert.Equal(t, 1, requestCount)
+
+	// a mutation to the same entity invalidates the cache
+	diags := ProcessRequest(ctx, mockProviderConf, struct{}{}, &roles{}, "mutation", "roles", "update")
+	assert.Empty(t, diags)
+	diags = ProcessRequest(ctx, mockProviderConf, struct{}{}, &roles{}, mockQuery, "roles", "read")
+	assert.Empty(t, diags)
+	assert.Equal(t, 3, requestCount)
+
+	// queries that are not whitelisted are never cached
+	mockProviderConf.QueryCache = config.NewQueryCache(config.QueryCacheTTL)
+	requestCount = 0
+	for i := 0; i < 2; i++ {
+		diags = ProcessRequest(ctx, mockProviderConf, struct{}{}, &roles{}, mockQuery, "users", "read")
+		assert.Empty(t, diags)
+	}
+	assert.Equal(t, 2, requestCount)
+}
+
+func TestProcessRequestQueryCacheRoleLookups(t *testing.T) {
+	// Mock data
+	mockQuery := "query role ($id: String) { role(id: $id) { id name } }"
+
+	// Mock context
+	ctx := context.TODO()
+
+	// Count the requests that reach the api
+	requestCount := 0
+	mockRoundTripper := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			responseBody := []byte(`{"data": {"role": {"id": "GLOBAL_READER", "name": "Global Reader"}}}`)
+			response := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody)),
+				Header:     make(http.Header),
+			}
+			return response, nil
+		},
+	}
+
+	// Mock config
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: mockRoundTripper,
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		UserAgent:  "Test User Agent",
+		TokenType:  "Bearer",
+		Token:      "testtoken",
+		QueryCache: config.NewQueryCache(config.QueryCacheTTL),
+	}
+
+	type role struct {
+		Role struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"role"`
+	}
+
+	// simulate 20 group mappings looking up the same role
+	for i := 0; i < 20; i++ {
+		data := &role{}
+		diags := ProcessRequest(ctx, mockProviderConf, map[string]string{"id": "GLOBAL_READER"}, data, mockQuery, "roles", "read")
+		assert.Empty(t, diags)
+		assert.Equal(t, "GLOBAL_READER", data.Role.ID)
+	}
+	assert.Equal(t, 1, requestCount)
+
+	// a lookup with other variables is a separate cache entry
+	diags := ProcessRequest(ctx, mockProviderConf, map[string]string{"id": "PROJECT_READER"}, &role{}, mockQuery, "roles", "read")
+	assert.Empty(t, diags)
+	assert.Equal(t, 2, requestCount)
+
+	// a role mutation invalidates the lookups
+	diags = ProcessRequest(ctx, mockProviderConf, struct{}{}, &role{}, "mutation", "roles", "update")
+	assert.Empty(t, diags)
+	diags = ProcessRequest(ctx, mockProviderConf, map[string]string{"id": "GLOBAL_READER"}, &role{}, mockQuery, "roles", "read")
+	assert.Empty(t, diags)
+	assert.Equal(t, 4, requestCount)
+}
+
+func TestWithQueryDetail(t *testing.T) {
+	query := `mutation CreateProject($input: CreateProjectInput!) { createProject(input: $input) { project { id name: "x" } } }`
+	failed := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "project create reported errors",
+		Detail:   "Response: []",
+	}
+
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "INFO")
+	if d := withQueryDetail(failed, query); !reflect.DeepEqual(failed, d) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", d, failed)
+	}
+
+	t.Setenv("TF_LOG", "debug")
+	expected := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "project create reported errors",
+		Detail:   "Response: []\n\nOperation: CreateProject\nQuery: mutation CreateProject($input: CreateProjectInput!) { createProject(input: $input) { project { id name: \"[REDACTED]\" } } }",
+	}
+	if d := withQueryDetail(failed, query); !reflect.DeepEqual(expected, d) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", d, expected)
+	}
+}
+
+func TestWithRequestDetail(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "INFO")
+
+	query := `mutation CreateProject($input: CreateProjectInput!) { createProject(input: $input) { project { id } } }`
+	vars := map[string]interface{}{"name": "p1", "webhookToken": "s3cr3t"}
+	failed := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "project create reported errors",
+		Detail:   "Response: [\n\t{\n\t\t\"message\": \"limit reached\",\n\t\t\"extensions\": {\n\t\t\t\"code\": \"QUOTA_EXCEEDED\"\n\t\t}\n\t}\n]",
+	}
+	m := func(level string) *config.ProviderConf {
+		return &config.ProviderConf{Settings: &config.Settings{DiagnosticDetailLevel: level}}
+	}
+
+	tests := []struct {
+		level    string
+		detail   diag.Diagnostic
+		expected string
+	}{
+		{
+			level:    "minimal",
+			detail:   failed,
+			expected: `Response: [{"message":"limit reached","extensions":{"code":"QUOTA_EXCEEDED"}}]`,
+		},
+		{
+			level: "minimal",
+			detail: diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "HTTP Response (502)",
+				Detail:   "Response: HTTP/1.1 502 Bad Gateway\r\n\r\nupstream error",
+			},
+			expected: "",
+		},
+		{
+			level:    "normal",
+			detail:   failed,
+			expected: failed.Detail + "\n\nRequest ID: a1b2c3",
+		},
+		{
+			level:    "verbose",
+			detail:   failed,
+			expected: failed.Detail + "\n\nRequest ID: a1b2c3\n\nOperation: CreateProject\nQuery: " + query + "\nVariables: {\n\t\"name\": \"p1\",\n\t\"webhookToken\": \"[REDACTED]\"\n}",
+		},
+	}
+
+	for _, tc := range tests {
+		d := withRequestDetail(m(tc.level), tc.detail, query, vars, "a1b2c3")
+		if d.Detail != tc.expected {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", d.Detail, tc.expected)
+		}
+		if tc.level == "minimal" && tc.expected != "" && !reflect.DeepEqual(ErrorCodes(d), []string{"QUOTA_EXCEEDED"}) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", ErrorCodes(d), []string{"QUOTA_EXCEEDED"})
+		}
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	failed := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "service_account create reported errors",
+		Detail:   "Response: [\n\t{\n\t\t\"message\": \"limit reached\",\n\t\t\"extensions\": {\n\t\t\t\"code\": \"QUOTA_EXCEEDED\"\n\t\t}\n\t}\n]\n\nOperation: CreateServiceAccount\nQuery: mutation CreateServiceAccount { }",
+	}
+
+	expected := []string{"QUOTA_EXCEEDED"}
+	if codes := ErrorCodes(failed); !reflect.DeepEqual(expected, codes) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", codes, expected)
+	}
+
+	if codes := ErrorCodes(diag.Diagnostic{Severity: diag.Error, Summary: "HTTP Response (500)"}); codes != nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", codes, nil)
+	}
+}
+
+func TestStrictDecodeData(t *testing.T) {
+	type project struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	type readProject struct {
+		Project project `json:"project"`
+	}
+
+	data := &readProject{}
+	err := strictDecodeData([]byte(`{"data":{"project":{"id":"1","name":"a"}}}`), data)
+	assert.NoError(t, err)
+	assert.Equal(t, &readProject{}, data)
+
+	err = strictDecodeData([]byte(`{"data":{"project":{"id":"1","projectName":"a"}}}`), data)
+	assert.EqualError(t, err, `json: unknown field "projectName"`)
+
+	err = strictDecodeData([]byte(`{"data":null,"errors":[]}`), data)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+// NotFoundErrorCode is the error code returned by the api when reading an object that does not exist
+const NotFoundErrorCode = "NOT_FOUND"
+
+// ConsistencyRetryWait is the wait before the first retry of a read that did not find the object, doubled on each retry
+var ConsistencyRetryWait = time.Second
+
+// ReadWithConsistencyRetry func - process a read request, retrying with backoff while the object is not found
+// objects are not always visible to reads immediately after they are created, so a read that fails with a not found error
+// is retried up to read_consistency_retries times, other errors are returned without retrying. found reports whether data holds the object after a request.
+// the diagnostics of the last attempt are returned, so callers treat an object that is still not found as deleted.
+func ReadWithConsistencyRetry(ctx context.Context, m interface{}, vars, data interface{}, query, resourceType string, found func() bool) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "client.ReadWithConsistencyRetry called...")
+
+	retries := m.(*config.ProviderConf).Settings.ReadConsistencyRetries
+	wait := ConsistencyRetryWait
+	for attempt := 0; ; attempt++ {
+		diags = ProcessBatchedReadRequest(ctx, m, vars, data, query, resourceType)
+		if found() || !isNotFound(diags) || attempt >= retries {
+			return diags
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("%s not found, retrying read in %s (retry %d of %d)", resourceType, wait, attempt+1, retries))
+		select {
+		case <-ctx.Done():
+			return append(diags, diag.FromErr(ctx.Err())...)
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// isNotFound reports whether diags holds errors and every error is a not found error reported by the api
+func isNotFound(diags diag.Diagnostics) bool {
+	if !diags.HasError() {
+		return false
+	}
+	for _, d := range diags {
+		if d.Severity == diag.Error && !slices.Contains(ErrorCodes(d), NotFoundErrorCode) {
+			return false
+		}
+	}
+	return true
+}
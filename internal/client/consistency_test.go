@@ -0,0 +1,86 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+func TestReadWithConsistencyRetry(t *testing.T) {
+	ctx := context.TODO()
+	ConsistencyRetryWait = 0
+
+	notFound := []byte(`{"data": {"control": null}, "errors": [{"message": "not found", "extensions": {"code": "NOT_FOUND"}}]}`)
+	found := []byte(`{"data": {"control": {"id": "5cd8e6a2-4d3a-4f1e-9c7b-5a6d8e9f0a1b"}}}`)
+
+	// the object becomes visible on the third request
+	requestCount := 0
+	visibleAfter := 3
+	mockRoundTripper := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			responseBody := notFound
+			if requestCount >= visibleAfter {
+				responseBody = found
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(responseBody)),
+				Header:     make(http.Header),
+			}, nil
+		},
+	}
+
+	mockProviderConf := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: mockRoundTripper,
+		},
+		Settings: &config.Settings{
+			WizURL:                 "http://example.com",
+			ReadConsistencyRetries: 3,
+		},
+	}
+
+	type control struct {
+		Control struct {
+			ID string `json:"id"`
+		} `json:"control"`
+	}
+
+	// transient invisibility is retried until the object is found
+	data := &control{}
+	diags := ReadWithConsistencyRetry(ctx, mockProviderConf, struct{}{}, data, "query", "control", func() bool { return data.Control.ID != "" })
+	assert.Empty(t, diags)
+	assert.Equal(t, "5cd8e6a2-4d3a-4f1e-9c7b-5a6d8e9f0a1b", data.Control.ID)
+	assert.Equal(t, 3, requestCount)
+
+	// a genuinely deleted object is reported as not found once the retries are exhausted
+	requestCount = 0
+	visibleAfter = 100
+	data = &control{}
+	diags = ReadWithConsistencyRetry(ctx, mockProviderConf, struct{}{}, data, "query", "control", func() bool { return data.Control.ID != "" })
+	assert.NotEmpty(t, diags)
+	assert.Empty(t, data.Control.ID)
+	assert.Equal(t, 4, requestCount)
+
+	// other errors are not retried
+	requestCount = 0
+	notFound = []byte(`{"data": {"control": null}, "errors": [{"message": "forbidden", "extensions": {"code": "FORBIDDEN"}}]}`)
+	data = &control{}
+	diags = ReadWithConsistencyRetry(ctx, mockProviderConf, struct{}{}, data, "query", "control", func() bool { return data.Control.ID != "" })
+	assert.NotEmpty(t, diags)
+	assert.Equal(t, 1, requestCount)
+
+	// retries can be disabled
+	requestCount = 0
+	mockProviderConf.Settings.ReadConsistencyRetries = 0
+	data = &control{}
+	diags = ReadWithConsistencyRetry(ctx, mockProviderConf, struct{}{}, data, "query", "control", func() bool { return data.Control.ID != "" })
+	assert.NotEmpty(t, diags)
+	assert.Equal(t, 1, requestCount)
+}
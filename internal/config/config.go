@@ -12,12 +12,15 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 )
 
 // Settings holds all the information necessary to configure the provider
@@ -28,12 +31,36 @@ type Settings struct {
 	WizAuthClientID        string
 	WizAuthClientSecret    string
 	WizAuthAudience        string
+	TenantID               string
 	Proxy                  bool
 	ProxyServer            string
 	CAChain                string
 	HTTPClientRetryMax     int
 	HTTPClientRetryWaitMin int
 	HTTPClientRetryWaitMax int
+	RetryMaxElapsedTime    int
+	RetryMaxInterval       int
+	QueryTimeout           int
+	MutationTimeout        int
+	ReadConsistencyRetries int
+	MaxPages               int
+	ValidateOnPlan         bool
+	ArchivedProjectPolicy  string
+	EnableReadBatching     bool
+	DisableQueryCache      bool
+	DebugHTTPDumpDir       string
+	WarnOnDeprecatedFields bool
+	StrictResponseDecoding bool
+	ReadOnly               bool
+	AssumeProjectID        string
+	DiagnosticDetailLevel  string
+	StatsOutputFile        string
+
+	SAMLIdPSkipReadAfterWrite      bool
+	GroupMappingProjectLimit       int
+	GroupMappingProjectLimitPolicy string
+	ForbiddenScopeProjectCombos    []string
+	ExtraHeaders                   map[string]string
 }
 
 // ProviderConf holds structures that are useful to the provider at runtime
@@ -43,6 +70,18 @@ type ProviderConf struct {
 	Token      string
 	HTTPClient *http.Client
 	UserAgent  string
+
+	// QueryCache holds responses for whitelisted static queries, nil when caching is disabled
+	QueryCache *QueryCache
+
+	// ReadBatcher coalesces concurrent reads by id into aliased requests, nil when batching is disabled
+	ReadBatcher *ReadBatcher
+
+	// ProjectSlugs caches project slug to project ID resolutions, entries are removed when the provider changes the project
+	ProjectSlugs sync.Map
+
+	// Stats counts the api requests for stats_output_file, nil when no file is set
+	Stats *APIStats
 }
 
 // AuthorizationResponse contains the reponse from the authorization api
@@ -55,6 +94,11 @@ type AuthorizationResponse struct {
 
 // GetHTTPClient creates a http client
 func GetHTTPClient(ctx context.Context, settings *Settings) *http.Client {
+	return getHTTPClient(ctx, settings, nil)
+}
+
+// getHTTPClient creates a http client, retried attempts are counted in stats when it is not nil
+func getHTTPClient(ctx context.Context, settings *Settings, stats *APIStats) *http.Client {
 	tflog.Info(ctx, "GetHTTPClient called...")
 
 	// load trusted certificate authorities in a certpool
@@ -80,10 +124,40 @@ func GetHTTPClient(ctx context.Context, settings *Settings) *http.Client {
 
 	// override with the trusted certificate authorities
 	client.HTTPClient.Transport = transport
+
+	// write redacted requests and responses to disk for post-mortem debugging
+	if settings.DebugHTTPDumpDir != "" {
+		client.HTTPClient.Transport = &httpDumpTransport{
+			next: transport,
+			dir:  settings.DebugHTTPDumpDir,
+		}
+	}
 	client.RetryWaitMin = time.Duration(settings.HTTPClientRetryWaitMin) * 1000000000
 	client.RetryWaitMax = time.Duration(settings.HTTPClientRetryWaitMax) * 1000000000
 	client.RetryMax = settings.HTTPClientRetryMax
 
+	// retry_max_interval caps each wait in place of http_client_retry_wait_max
+	if settings.RetryMaxInterval > 0 {
+		client.RetryWaitMax = time.Duration(settings.RetryMaxInterval) * time.Second
+	}
+	if stats != nil {
+		client.RequestLogHook = func(_ retryablehttp.Logger, _ *http.Request, attempt int) {
+			if attempt > 0 {
+				stats.RecordRetry()
+			}
+		}
+	}
+
+	// stop retrying a request once its retries would take longer than the retry budget
+	if settings.RetryMaxElapsedTime > 0 {
+		client.CheckRetry = retryBudgetPolicy(client, time.Duration(settings.RetryMaxElapsedTime)*time.Second)
+		standardClient := client.StandardClient()
+		standardClient.Transport = &retryBudgetTransport{
+			next: standardClient.Transport,
+		}
+		return standardClient
+	}
+
 	return client.StandardClient()
 }
 
@@ -94,11 +168,20 @@ func NewProviderConf(ctx context.Context, settings *Settings, userAgent string)
 	tokenType, token, diags := GetSessionToken(ctx, settings)
 
 	pcfg := &ProviderConf{
-		Settings:   settings,
-		Token:      token,
-		TokenType:  tokenType,
-		HTTPClient: GetHTTPClient(ctx, settings),
-		UserAgent:  userAgent,
+		Settings:  settings,
+		Token:     token,
+		TokenType: tokenType,
+		UserAgent: userAgent,
+	}
+	if settings.StatsOutputFile != "" {
+		pcfg.Stats = NewAPIStats(settings.StatsOutputFile)
+	}
+	pcfg.HTTPClient = getHTTPClient(ctx, settings, pcfg.Stats)
+	if !settings.DisableQueryCache {
+		pcfg.QueryCache = NewQueryCache(QueryCacheTTL)
+	}
+	if settings.EnableReadBatching {
+		pcfg.ReadBatcher = NewReadBatcher(ReadBatchWindow)
 	}
 	return pcfg, diags
 }
@@ -112,17 +195,95 @@ func NewConfig(d *schema.ResourceData) (*Settings, error) {
 		WizAuthClientID:        d.Get("wiz_auth_client_id").(string),
 		WizAuthClientSecret:    d.Get("wiz_auth_client_secret").(string),
 		WizAuthAudience:        d.Get("wiz_auth_audience").(string),
+		TenantID:               d.Get("tenant_id").(string),
 		Proxy:                  d.Get("proxy").(bool),
 		ProxyServer:            d.Get("proxy_server").(string),
 		CAChain:                d.Get("ca_chain").(string),
 		HTTPClientRetryMax:     d.Get("http_client_retry_max").(int),
 		HTTPClientRetryWaitMin: d.Get("http_client_retry_wait_min").(int),
 		HTTPClientRetryWaitMax: d.Get("http_client_retry_wait_max").(int),
+		RetryMaxElapsedTime:    d.Get("retry_max_elapsed_time").(int),
+		RetryMaxInterval:       d.Get("retry_max_interval").(int),
+		QueryTimeout:           d.Get("query_timeout").(int),
+		MutationTimeout:        d.Get("mutation_timeout").(int),
+		ReadConsistencyRetries: d.Get("read_consistency_retries").(int),
+		MaxPages:               d.Get("max_pages").(int),
+		ValidateOnPlan:         d.Get("validate_on_plan").(bool),
+		ArchivedProjectPolicy:  d.Get("archived_project_policy").(string),
+		EnableReadBatching:     d.Get("enable_read_batching").(bool),
+		DisableQueryCache:      d.Get("disable_query_cache").(bool),
+		DebugHTTPDumpDir:       d.Get("debug_http_dump_dir").(string),
+		WarnOnDeprecatedFields: d.Get("warn_on_deprecated_fields").(bool),
+		StrictResponseDecoding: d.Get("strict_response_decoding").(bool),
+		ReadOnly:               d.Get("read_only").(bool),
+		AssumeProjectID:        d.Get("assume_project_id").(string),
+		DiagnosticDetailLevel:  d.Get("diagnostic_detail_level").(string),
+		StatsOutputFile:        d.Get("stats_output_file").(string),
+
+		SAMLIdPSkipReadAfterWrite:      d.Get("saml_idp_skip_read_after_write").(bool),
+		GroupMappingProjectLimit:       d.Get("group_mapping_project_limit").(int),
+		GroupMappingProjectLimitPolicy: d.Get("group_mapping_project_limit_policy").(string),
+		ForbiddenScopeProjectCombos:    utils.ConvertListToString(d.Get("forbidden_scope_project_combos").([]interface{})),
+		ExtraHeaders:                   make(map[string]string),
+	}
+	for name, value := range d.Get("extra_headers").(map[string]interface{}) {
+		cfg.ExtraHeaders[name] = value.(string)
+	}
+
+	// arguments that are not set in the provider configuration are taken from the profile
+	if profileName := d.Get("profile").(string); profileName != "" {
+		profile, err := ReadProfile(d.Get("shared_credentials_file").(string), profileName)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range profile {
+			if isConfigured(d, key) {
+				continue
+			}
+			switch key {
+			case "tenant_id":
+				cfg.TenantID = value
+			case "wiz_auth_audience":
+				cfg.WizAuthAudience = value
+			case "wiz_auth_client_id":
+				cfg.WizAuthClientID = value
+			case "wiz_auth_client_secret":
+				cfg.WizAuthClientSecret = value
+			case "wiz_auth_url":
+				cfg.WizAuthURL = value
+			case "wiz_url":
+				cfg.WizURL = value
+			}
+		}
+	}
+
+	// the endpoint and credentials can come from a profile, so they are only required here
+	for _, a := range []struct {
+		name  string
+		env   string
+		value string
+	}{
+		{"wiz_url", "WIZ_URL", cfg.WizURL},
+		{"wiz_auth_client_id", "WIZ_AUTH_CLIENT_ID", cfg.WizAuthClientID},
+		{"wiz_auth_client_secret", "WIZ_AUTH_CLIENT_SECRET", cfg.WizAuthClientSecret},
+	} {
+		if a.value == "" {
+			return nil, fmt.Errorf("%s must be set in the provider configuration, the %s environment variable or the selected profile", a.name, a.env)
+		}
 	}
 
 	return cfg, nil
 }
 
+// isConfigured reports whether a provider argument is set in the provider configuration, rather than by an environment variable or a default
+func isConfigured(d *schema.ResourceData, name string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(name) {
+		return false
+	}
+	return !raw.GetAttr(name).IsNull()
+}
+
 // GetSessionToken retrieves a new session token
 func GetSessionToken(ctx context.Context, settings *Settings) (string, string, diag.Diagnostics) {
 	tflog.Info(ctx, "GetSessionToken called...")
@@ -151,7 +312,7 @@ func GetSessionToken(ctx context.Context, settings *Settings) (string, string, d
 		return "", "", append(diags, diag.FromErr(err)...)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("authentication request: %s", reqDump))
+	tflog.Debug(ctx, fmt.Sprintf("authentication request: %s", utils.RedactHTTPDump(reqDump)))
 
 	// call the api
 	resp, err := httpclient.Do(request)
@@ -164,7 +325,7 @@ func GetSessionToken(ctx context.Context, settings *Settings) (string, string, d
 	if err != nil {
 		return "", "", append(diags, diag.FromErr(err)...)
 	}
-	tflog.Debug(ctx, fmt.Sprintf("auth response: %s", respDump))
+	tflog.Debug(ctx, fmt.Sprintf("auth response: %s", utils.RedactHTTPDump(respDump)))
 
 	defer resp.Body.Close()
 
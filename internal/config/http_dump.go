@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// httpDumpTransport struct -- writes every request and response to the debug dump directory
+// failures to write are logged with the context of the request, so no configure-time context is kept
+type httpDumpTransport struct {
+	next http.RoundTripper
+	dir  string
+}
+
+// RoundTrip writes the redacted request and response dumps, failures to write are logged and otherwise ignored
+func (t *httpDumpTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	reqDump, err := httputil.DumpRequestOut(request, true)
+	if err == nil {
+		t.write(request.Context(), "request", reqDump)
+	}
+
+	resp, err := t.next.RoundTrip(request)
+	if err != nil {
+		t.write(request.Context(), "error", []byte(err.Error()))
+		return resp, err
+	}
+
+	respDump, err := httputil.DumpResponse(resp, true)
+	if err == nil {
+		t.write(request.Context(), "response", respDump)
+	}
+	return resp, nil
+}
+
+func (t *httpDumpTransport) write(ctx context.Context, name string, dump []byte) {
+	filename, err := utils.WriteHTTPDump(t.dir, name, dump)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to write http dump to %s: %s", t.dir, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("wrote http dump %s", filename))
+}
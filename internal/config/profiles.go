@@ -0,0 +1,105 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultSharedCredentialsFile is the credentials file used when shared_credentials_file is not set
+const DefaultSharedCredentialsFile = "~/.wiz/credentials"
+
+// profileKeys are the provider arguments that can be set in a profile
+var profileKeys = []string{
+	"tenant_id",
+	"wiz_auth_audience",
+	"wiz_auth_client_id",
+	"wiz_auth_client_secret",
+	"wiz_auth_url",
+	"wiz_url",
+}
+
+// ReadProfile returns the settings of a named profile in a shared credentials file
+func ReadProfile(path, name string) (map[string]string, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read shared credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles, err := parseProfiles(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in %s", name, path)
+	}
+	return profile, nil
+}
+
+// parseProfiles parses an ini style credentials file, with a [name] section per profile and key = value lines
+// blank lines and lines starting with # or ; are ignored
+func parseProfiles(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";"):
+			continue
+		case strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]"):
+			name := strings.TrimSpace(text[1 : len(text)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", line)
+			}
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("line %d: profile %s is defined more than once", line, name)
+			}
+			current = make(map[string]string)
+			profiles[name] = current
+		default:
+			key, value, found := strings.Cut(text, "=")
+			if !found {
+				return nil, fmt.Errorf("line %d: expected key = value", line)
+			}
+			if current == nil {
+				return nil, fmt.Errorf("line %d: setting outside of a profile", line)
+			}
+			key = strings.TrimSpace(key)
+			if !isProfileKey(key) {
+				return nil, fmt.Errorf("line %d: unsupported setting %s, expected one of %s", line, key, strings.Join(profileKeys, ", "))
+			}
+			current[key] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// isProfileKey reports whether key can be set in a profile
+func isProfileKey(key string) bool {
+	for _, a := range profileKeys {
+		if a == key {
+			return true
+		}
+	}
+	return false
+}
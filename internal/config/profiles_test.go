@@ -0,0 +1,92 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testCredentialsFile = `# shared wiz credentials
+[prod]
+wiz_url = https://api.us17.app.wiz.io/graphql
+wiz_auth_client_id = prod-client
+wiz_auth_client_secret = prod=secret
+
+; staging tenant of the same service account
+[staging]
+wiz_url=https://api.us17.app.wiz.io/graphql
+tenant_id = 0e1d7a3b-5c2f-4a8e-9b6d-3f2c1a0e9d8b
+`
+
+func TestParseProfiles(t *testing.T) {
+	expected := map[string]map[string]string{
+		"prod": {
+			"wiz_url":                "https://api.us17.app.wiz.io/graphql",
+			"wiz_auth_client_id":     "prod-client",
+			"wiz_auth_client_secret": "prod=secret",
+		},
+		"staging": {
+			"wiz_url":   "https://api.us17.app.wiz.io/graphql",
+			"tenant_id": "0e1d7a3b-5c2f-4a8e-9b6d-3f2c1a0e9d8b",
+		},
+	}
+
+	profiles, err := parseProfiles(strings.NewReader(testCredentialsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, profiles) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			profiles,
+			expected,
+		)
+	}
+
+	var errTests = map[string]string{
+		"wiz_url = https://example.com": "line 1: setting outside of a profile",
+		"[prod]\nwiz_url":               "line 2: expected key = value",
+		"[prod]\nregion = us17":         "line 2: unsupported setting region, expected one of tenant_id, wiz_auth_audience, wiz_auth_client_id, wiz_auth_client_secret, wiz_auth_url, wiz_url",
+		"[prod]\n[prod]":                "line 2: profile prod is defined more than once",
+		"[ ]":                           "line 1: empty profile name",
+		"[prod]\nwiz_url = https://example.com\n[ ]": "line 3: empty profile name",
+	}
+	for input, expectedErr := range errTests {
+		_, err := parseProfiles(strings.NewReader(input))
+		if err == nil || err.Error() != expectedErr {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				err,
+				expectedErr,
+			)
+		}
+	}
+}
+
+func TestReadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte(testCredentialsFile), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := ReadProfile(path, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile["tenant_id"] != "0e1d7a3b-5c2f-4a8e-9b6d-3f2c1a0e9d8b" {
+		t.Fatalf("Got:\n\n%#v\n\nExpected the staging profile\n", profile)
+	}
+
+	_, err = ReadProfile(path, "dev")
+	if err == nil || err.Error() != "profile dev not found in "+path {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a profile not found error\n", err)
+	}
+
+	_, err = ReadProfile(filepath.Join(t.TempDir(), "missing"), "prod")
+	if err == nil || !strings.HasPrefix(err.Error(), "unable to read shared credentials file") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a missing file error\n", err)
+	}
+}
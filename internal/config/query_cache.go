@@ -0,0 +1,79 @@
+package config
+
+import (
+	"sync"
+	"time"
+)
+
+// QueryCacheTTL is the lifetime of a cached query response
+const QueryCacheTTL = 5 * time.Minute
+
+// QueryCache struct -- in-memory cache of raw responses for static, read-only queries
+type QueryCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]queryCacheEntry
+}
+
+// queryCacheEntry struct
+type queryCacheEntry struct {
+	group   string
+	body    []byte
+	expires time.Time
+}
+
+// NewQueryCache returns an empty query cache whose entries expire after ttl
+func NewQueryCache(ttl time.Duration) *QueryCache {
+	return &QueryCache{
+		ttl:     ttl,
+		entries: make(map[string]queryCacheEntry),
+	}
+}
+
+// Get returns the cached response body for key, if present and not expired
+func (c *QueryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// Set stores the response body for key, tagged with the group used for invalidation
+func (c *QueryCache) Set(key, group string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = queryCacheEntry{
+		group:   group,
+		body:    body,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+// Invalidate removes all cached responses for group
+func (c *QueryCache) Invalidate(group string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if entry.group == group {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Clear removes all cached responses
+func (c *QueryCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]queryCacheEntry)
+}
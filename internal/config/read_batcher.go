@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// ReadBatchWindow is how long the first read of a batch waits for other reads to join it
+const ReadBatchWindow = 20 * time.Millisecond
+
+// ReadBatchMaxSize is the maximum number of reads sent in a single batched request
+const ReadBatchMaxSize = 50
+
+// ReadBatcher struct -- collects reads of the same shape issued within a short window so they can be sent as one request
+type ReadBatcher struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending map[string]*ReadBatch
+}
+
+// ReadBatch struct -- a group of reads sent together, Done is closed once every read has its result
+type ReadBatch struct {
+	Reads []*BatchedRead
+	Done  chan struct{}
+}
+
+// BatchedRead struct -- a single read by id waiting in a batch
+// Result is empty when the batched request failed or returned nothing for the id
+type BatchedRead struct {
+	ID     string
+	Result json.RawMessage
+}
+
+// NewReadBatcher returns a read batcher that collects reads for window
+func NewReadBatcher(window time.Duration) *ReadBatcher {
+	return &ReadBatcher{
+		window:  window,
+		pending: make(map[string]*ReadBatch),
+	}
+}
+
+// Window returns how long the first read of a batch waits for other reads
+func (b *ReadBatcher) Window() time.Duration {
+	return b.window
+}
+
+// Join adds read to the open batch for key, opening a new batch when there is none or it is full
+// leader is true for the first read of a batch, which is responsible for sending it and closing Done
+func (b *ReadBatcher) Join(key string, read *BatchedRead) (batch *ReadBatch, leader bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch, ok := b.pending[key]
+	if ok && len(batch.Reads) < ReadBatchMaxSize {
+		batch.Reads = append(batch.Reads, read)
+		return batch, false
+	}
+
+	batch = &ReadBatch{
+		Reads: []*BatchedRead{read},
+		Done:  make(chan struct{}),
+	}
+	b.pending[key] = batch
+	return batch, true
+}
+
+// Seal stops batch from accepting reads and returns the reads collected for it
+func (b *ReadBatcher) Seal(key string, batch *ReadBatch) []*BatchedRead {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending[key] == batch {
+		delete(b.pending, key)
+	}
+	return batch.Reads
+}
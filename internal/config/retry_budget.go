@@ -0,0 +1,58 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// retryBudgetKey is the request context key holding the retry budget of a request
+type retryBudgetKey struct{}
+
+// retryBudget struct -- tracks the retries of a single request, including the retries done by the http client
+type retryBudget struct {
+	start    time.Time
+	attempts int
+}
+
+// retryBudgetTransport struct -- starts the retry budget clock for every request
+type retryBudgetTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip adds a new retry budget to the request context
+func (t *retryBudgetTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	budget := &retryBudget{
+		start: time.Now(),
+	}
+	return t.next.RoundTrip(request.WithContext(context.WithValue(request.Context(), retryBudgetKey{}, budget)))
+}
+
+// retryBudgetPolicy returns a retry policy that stops retrying when the next wait would take the request past maxElapsed
+// the returned error is wrapped by the http client, which adds the number of attempts
+func retryBudgetPolicy(client *retryablehttp.Client, maxElapsed time.Duration) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		budget, ok := ctx.Value(retryBudgetKey{}).(*retryBudget)
+		if !retry || !ok {
+			return retry, checkErr
+		}
+
+		attempt := budget.attempts
+		budget.attempts++
+		elapsed := time.Since(budget.start)
+		wait := client.Backoff(client.RetryWaitMin, client.RetryWaitMax, attempt, resp)
+		if elapsed+wait <= maxElapsed {
+			return retry, checkErr
+		}
+
+		lastErr := err
+		if lastErr == nil && resp != nil {
+			lastErr = fmt.Errorf("HTTP Response (%d)", resp.StatusCode)
+		}
+		return false, fmt.Errorf("retry_max_elapsed_time of %s exceeded after %s: %w", maxElapsed, elapsed.Round(time.Millisecond), lastErr)
+	}
+}
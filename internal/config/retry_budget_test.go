@@ -0,0 +1,102 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestRetryBudgetPolicy(t *testing.T) {
+	client := retryablehttp.NewClient()
+	client.RetryWaitMin = time.Second
+	client.RetryWaitMax = 4 * time.Second
+	policy := retryBudgetPolicy(client, 10*time.Second)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable}
+
+	// within the budget the request is retried
+	budget := &retryBudget{start: time.Now()}
+	ctx := context.WithValue(context.Background(), retryBudgetKey{}, budget)
+	retry, err := policy(ctx, resp, nil)
+	if !retry || err != nil {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected a retry\n", retry, err)
+	}
+
+	// the next wait would exceed the budget
+	budget.start = time.Now().Add(-9 * time.Second)
+	retry, err = policy(ctx, resp, nil)
+	if retry || err == nil || !strings.Contains(err.Error(), "retry_max_elapsed_time of 10s exceeded") || !strings.Contains(err.Error(), "HTTP Response (503)") {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected the retry budget to be exceeded\n", retry, err)
+	}
+	if budget.attempts != 2 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", budget.attempts, 2)
+	}
+
+	// requests without a budget use the default policy
+	retry, err = policy(context.Background(), resp, nil)
+	if !retry || err != nil {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected a retry\n", retry, err)
+	}
+}
+
+func TestGetHTTPClientRetryMaxElapsedTime(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := GetHTTPClient(context.Background(), &Settings{
+		HTTPClientRetryMax:     10,
+		HTTPClientRetryWaitMin: 1,
+		HTTPClientRetryWaitMax: 1,
+		RetryMaxElapsedTime:    1,
+	})
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "giving up after 1 attempt(s)") || !strings.Contains(err.Error(), "retry_max_elapsed_time of 1s exceeded") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected the retry budget to be exceeded\n", err.Error())
+	}
+	if requests != 1 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", requests, 1)
+	}
+}
+
+func TestGetHTTPClientRetryMaxInterval(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// the waits are capped at 1s, so a 3s budget allows two retries instead of one
+	client := GetHTTPClient(context.Background(), &Settings{
+		HTTPClientRetryMax:     10,
+		HTTPClientRetryWaitMin: 1,
+		HTTPClientRetryWaitMax: 30,
+		RetryMaxElapsedTime:    3,
+		RetryMaxInterval:       1,
+	})
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "giving up after 3 attempt(s)") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected the retry budget to be exceeded\n", err.Error())
+	}
+	if requests != 3 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", requests, 3)
+	}
+}
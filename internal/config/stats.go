@@ -0,0 +1,132 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// APIStats struct -- counts the Wiz API requests of a provider instance and writes a summary to a file
+// Terraform does not tell providers when an operation ends, so the summary is rewritten after every request
+type APIStats struct {
+	mu         sync.Mutex
+	path       string
+	started    time.Time
+	operations map[string]int
+	retries    int
+	bytesSent  int64
+	bytesRecv  int64
+	latencies  []time.Duration
+}
+
+// APIStatsSummary struct -- the JSON summary written to the stats output file
+type APIStatsSummary struct {
+	StartedAt     string         `json:"started_at"`
+	TotalRequests int            `json:"total_requests"`
+	Operations    map[string]int `json:"operations"`
+	TotalRetries  int            `json:"total_retries"`
+	LatencyP50Ms  int64          `json:"latency_p50_ms"`
+	LatencyP95Ms  int64          `json:"latency_p95_ms"`
+	BytesSent     int64          `json:"bytes_sent"`
+	BytesReceived int64          `json:"bytes_received"`
+	TotalBytes    int64          `json:"total_bytes"`
+}
+
+// NewAPIStats returns statistics that are written to path
+func NewAPIStats(path string) *APIStats {
+	return &APIStats{
+		path:       path,
+		started:    time.Now().UTC(),
+		operations: make(map[string]int),
+	}
+}
+
+// RecordRequest adds a request to the statistics and rewrites the summary file
+// operation identifies the request, e.g. "project read", latency includes the retries of the request
+func (s *APIStats) RecordRequest(operation string, latency time.Duration, sent int64, received int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operations[operation]++
+	s.latencies = append(s.latencies, latency)
+	s.bytesSent += sent
+	s.bytesRecv += received
+	return s.write()
+}
+
+// RecordRetry counts a retried attempt of a request
+func (s *APIStats) RecordRetry() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retries++
+}
+
+// Summary returns the totals of the requests recorded so far
+func (s *APIStats) Summary() APIStatsSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.summary()
+}
+
+func (s *APIStats) summary() APIStatsSummary {
+	operations := make(map[string]int, len(s.operations))
+	for k, v := range s.operations {
+		operations[k] = v
+	}
+	latencies := append([]time.Duration(nil), s.latencies...)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	return APIStatsSummary{
+		StartedAt:     s.started.Format(time.RFC3339),
+		TotalRequests: len(latencies),
+		Operations:    operations,
+		TotalRetries:  s.retries,
+		LatencyP50Ms:  latencyPercentile(latencies, 50).Milliseconds(),
+		LatencyP95Ms:  latencyPercentile(latencies, 95).Milliseconds(),
+		BytesSent:     s.bytesSent,
+		BytesReceived: s.bytesRecv,
+		TotalBytes:    s.bytesSent + s.bytesRecv,
+	}
+}
+
+// write replaces the summary file, the summary is written to a temporary file first so readers never see a partial file
+func (s *APIStats) write() error {
+	body, err := json.MarshalIndent(s.summary(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("unable to write stats_output_file: %w", err)
+	}
+	_, err = tmp.Write(append(body, '\n'))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("unable to write stats_output_file: %w", err)
+	}
+	return nil
+}
+
+// latencyPercentile returns the nearest-rank percentile of sorted latencies, or zero when there are none
+func latencyPercentile(sorted []time.Duration, percentile int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (percentile*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAPIStats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wiz-stats.json")
+	stats := NewAPIStats(path)
+
+	stats.RecordRetry()
+	for i := 1; i <= 20; i++ {
+		operation := "project read"
+		if i%4 == 0 {
+			operation = "project update"
+		}
+		err := stats.RecordRequest(operation, time.Duration(i)*10*time.Millisecond, 100, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary := APIStatsSummary{}
+	err = json.Unmarshal(body, &summary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := stats.Summary()
+	expectedCounts := APIStatsSummary{
+		StartedAt:     summary.StartedAt,
+		TotalRequests: 20,
+		Operations: map[string]int{
+			"project read":   15,
+			"project update": 5,
+		},
+		TotalRetries:  1,
+		LatencyP50Ms:  100,
+		LatencyP95Ms:  190,
+		BytesSent:     2000,
+		BytesReceived: 20000,
+		TotalBytes:    22000,
+	}
+	if !reflect.DeepEqual(summary, expectedCounts) || !reflect.DeepEqual(expected, expectedCounts) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", summary, expectedCounts)
+	}
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	if p := latencyPercentile(nil, 95); p != 0 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", p, 0)
+	}
+	sorted := []time.Duration{time.Millisecond}
+	if p := latencyPercentile(sorted, 50); p != time.Millisecond {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", p, time.Millisecond)
+	}
+}
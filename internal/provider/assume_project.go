@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+// checkAssumedProject verifies that the provider credential can only operate on the project set in assume_project_id
+// Wiz does not narrow tokens on request, tokens are scoped by the projects assigned to the service account that requests them,
+// so the check fails unless the credential belongs to a service account assigned to that project alone
+func checkAssumedProject(ctx context.Context, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "checkAssumedProject called...")
+
+	projectID := m.(*config.ProviderConf).Settings.AssumeProjectID
+
+	// define the graphql query
+	query := `query viewer {
+	    viewer {
+	        __typename
+	        id
+	    }
+	}`
+
+	// process the request
+	viewer := &ReadViewerPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, struct{}{}, viewer, query, "viewer", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	if viewer.Viewer.TypeName != "ServiceAccount" {
+		return assumedProjectDiags(projectID, viewer.Viewer.TypeName, nil)
+	}
+
+	// define the graphql query
+	query = `query serviceAccount ($id: ID!){
+	    serviceAccount(
+	        id: $id
+	    ) {
+	        id
+	        assignedProjects {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = viewer.Viewer.ID
+
+	// process the request
+	data := &ReadServiceAccountPayload{}
+	requestDiags = client.ProcessRequest(ctx, m, vars, data, query, "service_account", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	var assignedProjects []string
+	for _, b := range data.ServiceAccount.AssignedProjects {
+		assignedProjects = append(assignedProjects, b.ID)
+	}
+	return assumedProjectDiags(projectID, viewer.Viewer.TypeName, assignedProjects)
+}
+
+// assumedProjectDiags returns an error unless the principal is a service account assigned to projectID alone
+func assumedProjectDiags(projectID string, typeName string, assignedProjects []string) diag.Diagnostics {
+	if typeName == "ServiceAccount" && len(assignedProjects) == 1 && assignedProjects[0] == projectID {
+		return nil
+	}
+
+	var detail string
+	switch {
+	case typeName != "ServiceAccount":
+		detail = "The provider credential does not belong to a service account."
+	case len(assignedProjects) == 0:
+		detail = "The service account is not assigned to any project, so its tokens can access all projects."
+	default:
+		detail = fmt.Sprintf("The service account is assigned to projects %v, so its tokens can access more than project %s.", assignedProjects, projectID)
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "Credential is not scoped to the assumed project",
+			Detail:   fmt.Sprintf("%s Wiz scopes API tokens by the projects assigned to the service account, so assume_project_id requires the credentials of a service account assigned to project %s only.", detail, projectID),
+		},
+	}
+}
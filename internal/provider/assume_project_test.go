@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestAssumedProjectDiags(t *testing.T) {
+	projectID := "ee25cc95-82b0-4543-8934-5bc655b86786"
+
+	var tests = []struct {
+		typeName         string
+		assignedProjects []string
+		valid            bool
+	}{
+		{"ServiceAccount", []string{projectID}, true},
+		{"ServiceAccount", nil, false},
+		{"ServiceAccount", []string{projectID, "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b"}, false},
+		{"ServiceAccount", []string{"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b"}, false},
+		{"User", []string{projectID}, false},
+	}
+
+	for _, tc := range tests {
+		diags := assumedProjectDiags(projectID, tc.typeName, tc.assignedProjects)
+		if diags.HasError() == tc.valid {
+			t.Fatalf("Got:\n\n%#v\n\nExpected valid=%t for %s %#v\n", diags, tc.valid, tc.typeName, tc.assignedProjects)
+		}
+	}
+}
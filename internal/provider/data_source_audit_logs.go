@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// auditLogsPageSize is the page size used to read audit log entries
+const auditLogsPageSize = 100
+
+// ReadAuditLogEntries struct
+type ReadAuditLogEntries struct {
+	AuditLogEntries wiz.AuditLogEntryConnection `json:"auditLogEntries"`
+}
+
+func dataSourceWizAuditLogs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get recent entries of the tenant audit log, newest first. Use this to review or alert on changes made outside Terraform.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"since": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Only return entries recorded after this time, as an RFC 3339 timestamp (e.g. `2024-01-01T00:00:00Z`).",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsRFC3339Time,
+				),
+			},
+			"action_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return entries for this action (e.g. `UpdateProject`).",
+			},
+			"max_results": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     500,
+				Description: "Maximum number of entries to return. Pages are only read until this many entries have been returned.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IntBetween(1, 10000),
+				),
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The returned audit log entries.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Wiz internal identifier.",
+						},
+						"timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the action was performed.",
+						},
+						"actor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Who performed the action: the user email, or the service account name.",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action performed.",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The parameters of the action, identifying the object it applied to, as normalized JSON.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The outcome of the action.",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizAuditLogsRead,
+	}
+}
+
+func dataSourceWizAuditLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizAuditLogsRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("since")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("action_type")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("max_results")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query auditLogEntries(
+	  $first: Int
+	  $after: String
+	  $filterBy: AuditLogEntryFilters
+	){
+	  auditLogEntries(
+	    first: $first
+	    after: $after
+	    filterBy: $filterBy
+	  ) {
+	      nodes {
+	        id
+	        timestamp
+	        action
+	        actionParameters
+	        status
+	        user {
+	          id
+	          name
+	          email
+	        }
+	        serviceAccount {
+	          id
+	          name
+	        }
+	      }
+	      pageInfo {
+	        endCursor
+	        hasNextPage
+	      }
+	      totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	// only the pages needed to reach max_results are read
+	maxResults := d.Get("max_results").(int)
+	vars := &internal.QueryVariables{}
+	vars.First = auditLogsPageSize
+	if maxResults < auditLogsPageSize {
+		vars.First = maxResults
+	}
+	filterBy := &wiz.AuditLogEntryFilters{
+		Timestamp: &wiz.AuditLogEntryTimestampFilter{
+			After: d.Get("since").(string),
+		},
+	}
+	if actionType, ok := d.GetOk("action_type"); ok {
+		filterBy.Action = []string{actionType.(string)}
+	}
+	vars.FilterBy = filterBy
+	maxPages := (maxResults + vars.First - 1) / vars.First
+
+	// process the request
+	data := &ReadAuditLogEntries{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "audit_logs", "read", maxPages)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	entries, err := flattenAuditLogEntries(ctx, allData, maxResults)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("entries", entries); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func flattenAuditLogEntries(ctx context.Context, auditLogEntries []interface{}, maxResults int) ([]interface{}, error) {
+	tflog.Info(ctx, "flattenAuditLogEntries called...")
+
+	// walk the slice and construct the list, stopping at max results
+	var output = make([]interface{}, 0)
+	for _, a := range auditLogEntries {
+		for _, b := range a.(*ReadAuditLogEntries).AuditLogEntries.Nodes {
+			if len(output) >= maxResults {
+				return output, nil
+			}
+			tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+			target := ""
+			if len(b.ActionParameters) > 0 && string(b.ActionParameters) != "null" {
+				normalized, err := utils.NormalizeJSON(string(b.ActionParameters))
+				if err != nil {
+					return nil, err
+				}
+				target = normalized
+			}
+			entryMap := make(map[string]interface{})
+			entryMap["id"] = b.ID
+			entryMap["timestamp"] = b.Timestamp
+			entryMap["actor"] = auditLogEntryActor(b)
+			entryMap["action"] = b.Action
+			entryMap["target"] = target
+			entryMap["status"] = b.Status
+			output = append(output, entryMap)
+		}
+	}
+	return output, nil
+}
+
+// auditLogEntryActor returns the email of the user that performed the action, or the service account name
+func auditLogEntryActor(entry *wiz.AuditLogEntry) string {
+	switch {
+	case entry.User != nil && entry.User.Email != "":
+		return entry.User.Email
+	case entry.User != nil:
+		return entry.User.Name
+	case entry.ServiceAccount != nil:
+		return entry.ServiceAccount.Name
+	}
+	return ""
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenAuditLogEntries(t *testing.T) {
+	ctx := context.Background()
+
+	var auditLogEntries = []interface{}{
+		&ReadAuditLogEntries{
+			AuditLogEntries: wiz.AuditLogEntryConnection{
+				Nodes: []*wiz.AuditLogEntry{
+					{
+						ID:               "f0c1d2e3-a4b5-4c6d-8e7f-9a0b1c2d3e4f",
+						Timestamp:        "2024-05-02T10:15:00Z",
+						Action:           "UpdateProject",
+						ActionParameters: json.RawMessage(`{"input": {"id": "ee25cc95-82b0-4543-8934-5bc655b86786"}}`),
+						Status:           "SUCCESS",
+						User: &wiz.AuditLogEntryActor{
+							ID:    "a1b2c3d4",
+							Name:  "Jane Doe",
+							Email: "jane@example.com",
+						},
+					},
+					{
+						ID:        "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+						Timestamp: "2024-05-02T09:00:00Z",
+						Action:    "CreateServiceAccount",
+						Status:    "SUCCESS",
+						ServiceAccount: &wiz.AuditLogEntryActor{
+							ID:   "sa-1",
+							Name: "terraform",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var expected = []interface{}{
+		map[string]interface{}{
+			"id":        "f0c1d2e3-a4b5-4c6d-8e7f-9a0b1c2d3e4f",
+			"timestamp": "2024-05-02T10:15:00Z",
+			"actor":     "jane@example.com",
+			"action":    "UpdateProject",
+			"target":    `{"input":{"id":"ee25cc95-82b0-4543-8934-5bc655b86786"}}`,
+			"status":    "SUCCESS",
+		},
+		map[string]interface{}{
+			"id":        "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+			"timestamp": "2024-05-02T09:00:00Z",
+			"actor":     "terraform",
+			"action":    "CreateServiceAccount",
+			"target":    "",
+			"status":    "SUCCESS",
+		},
+	}
+
+	entries, err := flattenAuditLogEntries(ctx, auditLogEntries, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			entries,
+			expected,
+		)
+	}
+
+	// max results caps the returned entries
+	entries, err = flattenAuditLogEntries(ctx, auditLogEntries, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entries, expected[:1]) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			entries,
+			expected[:1],
+		)
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizAutomationTriggers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the automation rule trigger types and trigger sources supported by the Wiz API, read from the API schema. Every trigger type can be combined with every trigger source. Useful to validate the `trigger_type` and `trigger_source` of automation rules, e.g. in variable validation. The result is cached for the duration of the apply.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the Wiz API URL.",
+			},
+			"trigger_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The trigger types, for the `trigger_type` of automation rules.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"trigger_sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The trigger sources, for the `trigger_source` of automation rules.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizAutomationTriggersRead,
+	}
+}
+
+// ReadAutomationTriggersPayload struct
+type ReadAutomationTriggersPayload struct {
+	TriggerTypes   *introspectionEnum `json:"triggerTypes"`
+	TriggerSources *introspectionEnum `json:"triggerSources"`
+}
+
+type introspectionEnum struct {
+	EnumValues []struct {
+		Name string `json:"name"`
+	} `json:"enumValues"`
+}
+
+// values returns the names of the enum values, or nil when the type is not in the schema
+func (e *introspectionEnum) values() []string {
+	if e == nil {
+		return nil
+	}
+	values := make([]string, 0, len(e.EnumValues))
+	for _, v := range e.EnumValues {
+		values = append(values, v.Name)
+	}
+	return values
+}
+
+func dataSourceWizAutomationTriggersRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizAutomationTriggersRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the api url
+	h := sha1.New()
+	h.Write([]byte(m.(*config.ProviderConf).Settings.WizURL))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	// define the graphql query
+	query := `query automationTriggers {
+	    triggerTypes: __type(name: "AutomationRuleTriggerType") {
+	        enumValues {
+	            name
+	        }
+	    }
+	    triggerSources: __type(name: "AutomationRuleTriggerSource") {
+	        enumValues {
+	            name
+	        }
+	    }
+	}`
+
+	// process the request
+	data := &ReadAutomationTriggersPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, map[string]interface{}{}, data, query, "automation_triggers", "read")
+	triggerTypes, triggerSources, fallbackDiags := automationTriggers(data, requestDiags)
+	diags = append(diags, fallbackDiags...)
+
+	err := d.Set("trigger_types", triggerTypes)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("trigger_sources", triggerSources)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// automationTriggers returns the trigger types and sources read from the schema
+// tenants with introspection disabled return an error, the values known to the provider are returned instead with a warning
+func automationTriggers(data *ReadAutomationTriggersPayload, requestDiags diag.Diagnostics) (triggerTypes []string, triggerSources []string, diags diag.Diagnostics) {
+	triggerTypes = data.TriggerTypes.values()
+	triggerSources = data.TriggerSources.values()
+	if !requestDiags.HasError() && triggerTypes != nil && triggerSources != nil {
+		return triggerTypes, triggerSources, diags
+	}
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Unable to read automation triggers from the Wiz API schema",
+		Detail:   "The Wiz API schema could not be introspected, which happens when introspection is disabled on the tenant. The trigger types and sources known to this provider version are returned instead.",
+	})
+	return wiz.AutomationRuleTriggerType, wiz.AutomationRuleTriggerSource, diags
+}
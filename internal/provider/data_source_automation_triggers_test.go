@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestAutomationTriggers(t *testing.T) {
+	data := &ReadAutomationTriggersPayload{}
+	err := json.Unmarshal([]byte(`{
+	    "triggerTypes": {"enumValues": [{"name": "CREATED"}, {"name": "UPDATED"}]},
+	    "triggerSources": {"enumValues": [{"name": "ISSUES"}, {"name": "CONTROL"}]}
+	}`), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	triggerTypes, triggerSources, diags := automationTriggers(data, nil)
+	expectedTypes := []string{"CREATED", "UPDATED"}
+	expectedSources := []string{"ISSUES", "CONTROL"}
+	if len(diags) != 0 || !reflect.DeepEqual(triggerTypes, expectedTypes) || !reflect.DeepEqual(triggerSources, expectedSources) {
+		t.Fatalf(
+			"Got:\n\n%#v %#v %#v\n\nExpected:\n\n%#v %#v\n",
+			triggerTypes, triggerSources, diags,
+			expectedTypes, expectedSources,
+		)
+	}
+
+	// the values known to the provider are returned when introspection is disabled
+	requestDiags := diag.Diagnostics{{Severity: diag.Error, Summary: "automation_triggers read reported errors"}}
+	triggerTypes, triggerSources, diags = automationTriggers(&ReadAutomationTriggersPayload{}, requestDiags)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning, got: %#v", diags)
+	}
+	if !reflect.DeepEqual(triggerTypes, wiz.AutomationRuleTriggerType) || !reflect.DeepEqual(triggerSources, wiz.AutomationRuleTriggerSource) {
+		t.Fatalf(
+			"Got:\n\n%#v %#v\n\nExpected:\n\n%#v %#v\n",
+			triggerTypes, triggerSources,
+			wiz.AutomationRuleTriggerType, wiz.AutomationRuleTriggerSource,
+		)
+	}
+}
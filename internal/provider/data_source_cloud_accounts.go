@@ -57,9 +57,10 @@ func dataSourceWizCloudAccounts() *schema.Resource {
 				},
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Query cloud accounts of a specific linked project, given its id.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Query cloud accounts of a specific linked project, given its id.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"cloud_provider": {
 				Type:     schema.TypeList,
@@ -172,6 +173,23 @@ func dataSourceWizCloudAccounts() *schema.Resource {
 					},
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizCloudAccountsRead,
 	}
@@ -373,6 +391,7 @@ func dataSourceWizCloudAccountsRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	cloudAccounts := flattenCloudAccounts(ctx, allData)
+	utils.SortFlattenedList(cloudAccounts, d.Get("order_by").(string))
 	if err := d.Set("cloud_accounts", cloudAccounts); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
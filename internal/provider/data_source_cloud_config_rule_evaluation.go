@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizCloudConfigurationRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the result of a cloud configuration rule for a single resource, e.g. to assert in a `check` block that a rule passes or fails a known sample resource. The result is read from the latest Wiz evaluation of the rule, so rules are only evaluated after they are created; `evaluated` is `false` until Wiz has assessed the resource.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The cloud configuration rule identifier.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Wiz identifier of the resource to evaluate the rule against.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"evaluated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Wiz has evaluated the rule against the resource.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The evaluation result (e.g. `PASS` or `FAIL`). Empty when the resource has not been evaluated.",
+			},
+			"finding_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the configuration finding holding the result.",
+			},
+			"configuration_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration path the rule checked, when reported by Wiz.",
+			},
+			"current_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value found on the resource, when reported by Wiz.",
+			},
+			"expected_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value the rule expects, when reported by Wiz.",
+			},
+		},
+		ReadContext: dataSourceWizCloudConfigurationRuleEvaluationRead,
+	}
+}
+
+// ReadCloudConfigurationRuleEvaluation struct
+type ReadCloudConfigurationRuleEvaluation struct {
+	ConfigurationFindings *wiz.ConfigurationFindingConnection `json:"configurationFindings"`
+}
+
+// CloudConfigurationRuleEvaluationVariables struct
+type CloudConfigurationRuleEvaluationVariables struct {
+	FilterBy *wiz.ConfigurationFindingFilters `json:"filterBy"`
+}
+
+func dataSourceWizCloudConfigurationRuleEvaluationRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizCloudConfigurationRuleEvaluationRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("rule_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("resource_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query cloudConfigurationRuleEvaluation (
+	    $filterBy: ConfigurationFindingFilters
+	){
+	    configurationFindings(
+	        first: 1
+	        filterBy: $filterBy
+	    ) {
+	        nodes {
+	            id
+	            result
+	            resource {
+	                id
+	            }
+	            evidence {
+	                configurationPath
+	                currentValue
+	                expectedValue
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &CloudConfigurationRuleEvaluationVariables{}
+	vars.FilterBy = &wiz.ConfigurationFindingFilters{
+		Resource: &wiz.ConfigurationFindingResourceFilters{
+			ID: []string{d.Get("resource_id").(string)},
+		},
+		Rule: &wiz.ConfigurationFindingRuleFilters{
+			ID: []string{d.Get("rule_id").(string)},
+		},
+	}
+
+	// process the request
+	data := &ReadCloudConfigurationRuleEvaluation{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "cloud_config_rule_evaluation", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	finding := flattenCloudConfigurationRuleEvaluation(ctx, data)
+	evidence := &wiz.ConfigurationFindingEvidence{}
+	if finding != nil && finding.Evidence != nil {
+		evidence = finding.Evidence
+	}
+
+	// set the data source parameters
+	err := d.Set("evaluated", finding != nil)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	result, findingID := "", ""
+	if finding != nil {
+		result, findingID = finding.Result, finding.ID
+	}
+	err = d.Set("result", result)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("finding_id", findingID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("configuration_path", evidence.ConfigurationPath)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("current_value", evidence.CurrentValue)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("expected_value", evidence.ExpectedValue)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenCloudConfigurationRuleEvaluation returns the finding of the rule for the resource
+// a resource the rule has not been evaluated against has no finding, which is returned as nil
+func flattenCloudConfigurationRuleEvaluation(ctx context.Context, data *ReadCloudConfigurationRuleEvaluation) *wiz.ConfigurationFinding {
+	tflog.Info(ctx, "flattenCloudConfigurationRuleEvaluation called...")
+
+	if data.ConfigurationFindings == nil {
+		return nil
+	}
+	for _, b := range data.ConfigurationFindings.Nodes {
+		tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+		if b != nil && b.Result != "" {
+			return b
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenCloudConfigurationRuleEvaluation(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.ConfigurationFinding{
+		ID:     "f1",
+		Result: "FAIL",
+		Resource: wiz.ConfigurationFindingResource{
+			ID: "5f1e2d3c-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+		},
+		Evidence: &wiz.ConfigurationFindingEvidence{
+			ConfigurationPath: "ServerSideEncryptionConfiguration",
+			CurrentValue:      "null",
+			ExpectedValue:     "AES256",
+		},
+	}
+	data := &ReadCloudConfigurationRuleEvaluation{
+		ConfigurationFindings: &wiz.ConfigurationFindingConnection{
+			Nodes: []*wiz.ConfigurationFinding{
+				expected,
+			},
+		},
+	}
+
+	finding := flattenCloudConfigurationRuleEvaluation(ctx, data)
+	if !reflect.DeepEqual(finding, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			finding,
+			expected,
+		)
+	}
+
+	finding = flattenCloudConfigurationRuleEvaluation(ctx, &ReadCloudConfigurationRuleEvaluation{
+		ConfigurationFindings: &wiz.ConfigurationFindingConnection{},
+	})
+	if finding != nil {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			finding,
+			nil,
+		)
+	}
+}
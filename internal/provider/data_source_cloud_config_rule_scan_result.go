@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizCloudConfigurationRuleScanResult() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the current evaluation results of a cloud configuration rule. Use this in a `check` block to assert that a new rule is live and matching resources. Rules that have not been evaluated yet return zero counts.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The cloud configuration rule identifier.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit the results to the resources of this project.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"sample_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of failing resource identifiers to return.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IntBetween(0, 500),
+				),
+			},
+			"pass_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of resources that pass the rule.",
+			},
+			"fail_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of resources that fail the rule.",
+			},
+			"failing_resource_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A sample of up to `sample_size` identifiers of failing resources.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizCloudConfigurationRuleScanResultRead,
+	}
+}
+
+// ReadCloudConfigurationRuleScanResult struct
+type ReadCloudConfigurationRuleScanResult struct {
+	Passed *wiz.ConfigurationFindingConnection `json:"passed"`
+	Failed *wiz.ConfigurationFindingConnection `json:"failed"`
+}
+
+// CloudConfigurationRuleScanResultVariables struct
+type CloudConfigurationRuleScanResultVariables struct {
+	First  int                              `json:"first"`
+	Passed *wiz.ConfigurationFindingFilters `json:"passed"`
+	Failed *wiz.ConfigurationFindingFilters `json:"failed"`
+}
+
+func dataSourceWizCloudConfigurationRuleScanResultRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizCloudConfigurationRuleScanResultRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("rule_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("project_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("sample_size")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	// the passed and failed findings are read in a single request using aliases
+	query := `query cloudConfigurationRuleScanResult (
+	    $first: Int
+	    $passed: ConfigurationFindingFilters
+	    $failed: ConfigurationFindingFilters
+	){
+	    passed: configurationFindings(
+	        first: 0
+	        filterBy: $passed
+	    ) {
+	        totalCount
+	    }
+	    failed: configurationFindings(
+	        first: $first
+	        filterBy: $failed
+	    ) {
+	        nodes {
+	            id
+	            resource {
+	                id
+	            }
+	        }
+	        totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	filterBy := func(result string) *wiz.ConfigurationFindingFilters {
+		filters := &wiz.ConfigurationFindingFilters{
+			Result: []string{result},
+			Rule: &wiz.ConfigurationFindingRuleFilters{
+				ID: []string{d.Get("rule_id").(string)},
+			},
+		}
+		if projectID, ok := d.GetOk("project_id"); ok {
+			filters.ProjectID = []string{projectID.(string)}
+		}
+		return filters
+	}
+	vars := &CloudConfigurationRuleScanResultVariables{}
+	vars.First = d.Get("sample_size").(int)
+	vars.Passed = filterBy("PASS")
+	vars.Failed = filterBy("FAIL")
+
+	// process the request
+	data := &ReadCloudConfigurationRuleScanResult{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "cloud_config_rule_scan_result", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	passCount, failCount, failingResourceIDs := flattenCloudConfigurationRuleScanResult(ctx, data)
+
+	// set the data source parameters
+	err := d.Set("pass_count", passCount)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("fail_count", failCount)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("failing_resource_ids", failingResourceIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenCloudConfigurationRuleScanResult returns the counts and failing resource sample
+// a rule that has not been evaluated yet has no findings, which is reported as zero counts
+func flattenCloudConfigurationRuleScanResult(ctx context.Context, data *ReadCloudConfigurationRuleScanResult) (passCount int, failCount int, failingResourceIDs []interface{}) {
+	tflog.Info(ctx, "flattenCloudConfigurationRuleScanResult called...")
+
+	failingResourceIDs = make([]interface{}, 0)
+	if data.Passed != nil {
+		passCount = data.Passed.TotalCount
+	}
+	if data.Failed != nil {
+		failCount = data.Failed.TotalCount
+		for _, b := range data.Failed.Nodes {
+			tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+			failingResourceIDs = append(failingResourceIDs, b.Resource.ID)
+		}
+	}
+	return passCount, failCount, failingResourceIDs
+}
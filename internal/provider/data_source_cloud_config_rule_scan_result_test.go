@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenCloudConfigurationRuleScanResult(t *testing.T) {
+	ctx := context.Background()
+
+	data := &ReadCloudConfigurationRuleScanResult{
+		Passed: &wiz.ConfigurationFindingConnection{
+			TotalCount: 12,
+		},
+		Failed: &wiz.ConfigurationFindingConnection{
+			TotalCount: 3,
+			Nodes: []*wiz.ConfigurationFinding{
+				{
+					ID: "f1",
+					Resource: wiz.ConfigurationFindingResource{
+						ID: "5f1e2d3c-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+					},
+				},
+				{
+					ID: "f2",
+					Resource: wiz.ConfigurationFindingResource{
+						ID: "6a2b3c4d-5e6f-4a7b-9c8d-0e1f2a3b4c5d",
+					},
+				},
+			},
+		},
+	}
+
+	expectedIDs := []interface{}{
+		"5f1e2d3c-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+		"6a2b3c4d-5e6f-4a7b-9c8d-0e1f2a3b4c5d",
+	}
+
+	passCount, failCount, failingResourceIDs := flattenCloudConfigurationRuleScanResult(ctx, data)
+	if passCount != 12 || failCount != 3 || !reflect.DeepEqual(failingResourceIDs, expectedIDs) {
+		t.Fatalf(
+			"Got:\n\n%d %d %#v\n\nExpected:\n\n%d %d %#v\n",
+			passCount, failCount, failingResourceIDs,
+			12, 3, expectedIDs,
+		)
+	}
+
+	// a rule that has not been evaluated yet returns empty counts
+	passCount, failCount, failingResourceIDs = flattenCloudConfigurationRuleScanResult(ctx, &ReadCloudConfigurationRuleScanResult{})
+	if passCount != 0 || failCount != 0 || len(failingResourceIDs) != 0 {
+		t.Fatalf("Expected empty counts for an unevaluated rule, got: %d %d %#v", passCount, failCount, failingResourceIDs)
+	}
+}
@@ -342,6 +342,23 @@ func dataSourceWizCloudConfigurationRules() *schema.Resource {
 					},
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizCloudConfigurationRuleRead,
 	}
@@ -688,6 +705,7 @@ func dataSourceWizCloudConfigurationRuleRead(ctx context.Context, d *schema.Reso
 	}
 
 	cloudConfigurationRules := flattenCloudConfigurationRules(ctx, &data.CloudConfigurationRules.Nodes)
+	utils.SortFlattenedList(cloudConfigurationRules, d.Get("order_by").(string))
 	if err := d.Set("cloud_configuration_rules", cloudConfigurationRules); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
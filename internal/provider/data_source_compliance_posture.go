@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizCompliancePosture() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the compliance posture of a security framework, optionally scoped to a project. Frameworks without evaluated resources return zero counts.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"framework_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The security framework identifier.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit the posture to the resources of this project.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"passed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of passed checks across all categories.",
+			},
+			"failed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of failed checks across all categories.",
+			},
+			"score_percentage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Percentage of passed checks across all categories.",
+			},
+			"categories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Compliance posture by category.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Wiz internal identifier for the category.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Category name.",
+						},
+						"passed_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of passed checks.",
+						},
+						"failed_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of failed checks.",
+						},
+						"score_percentage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Percentage of passed checks.",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizCompliancePostureRead,
+	}
+}
+
+// ReadCompliancePosture struct
+type ReadCompliancePosture struct {
+	SecurityFramework wiz.SecurityFramework `json:"securityFramework"`
+}
+
+// CompliancePostureVariables struct
+type CompliancePostureVariables struct {
+	ID        string                                  `json:"id"`
+	Selection *wiz.SecurityCategoryAnalyticsSelection `json:"selection,omitempty"`
+}
+
+func dataSourceWizCompliancePostureRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizCompliancePostureRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("framework_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("project_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query securityFrameworkPosture (
+	    $id: ID!
+	    $selection: SecurityCategoryAnalyticsSelection
+	){
+	    securityFramework(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        categories {
+	            id
+	            name
+	            analytics(
+	                selection: $selection
+	            ) {
+	                passedCount
+	                failedCount
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &CompliancePostureVariables{}
+	vars.ID = d.Get("framework_id").(string)
+	a, b = d.GetOk("project_id")
+	if b {
+		vars.Selection = &wiz.SecurityCategoryAnalyticsSelection{
+			Project: []string{a.(string)},
+		}
+	}
+
+	// process the request
+	data := &ReadCompliancePosture{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "compliance_posture", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	passed, failed, categories := flattenCompliancePosture(ctx, data.SecurityFramework.Categories)
+	err := d.Set("passed_count", passed)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("failed_count", failed)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("score_percentage", compliancePostureScore(passed, failed))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("categories", categories)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// compliancePostureScore returns the percentage of passed checks, or zero when nothing has been evaluated
+func compliancePostureScore(passed, failed int) float64 {
+	if passed+failed == 0 {
+		return 0
+	}
+	return float64(passed) / float64(passed+failed) * 100
+}
+
+func flattenCompliancePosture(ctx context.Context, categories []wiz.SecurityCategory) (passed int, failed int, output []interface{}) {
+	tflog.Info(ctx, "flattenCompliancePosture called...")
+
+	output = make([]interface{}, 0)
+	for _, c := range categories {
+		tflog.Trace(ctx, fmt.Sprintf("c: %T %s", c, utils.PrettyPrint(c)))
+		analytics := wiz.SecurityCategoryAnalytics{}
+		if c.Analytics != nil {
+			analytics = *c.Analytics
+		}
+		passed += analytics.PassedCount
+		failed += analytics.FailedCount
+
+		category := make(map[string]interface{})
+		category["id"] = c.ID
+		category["name"] = c.Name
+		category["passed_count"] = analytics.PassedCount
+		category["failed_count"] = analytics.FailedCount
+		category["score_percentage"] = compliancePostureScore(analytics.PassedCount, analytics.FailedCount)
+		output = append(output, category)
+	}
+	return passed, failed, output
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenCompliancePosture(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":               "wct-id-1",
+			"name":             "AM Asset Management",
+			"passed_count":     3,
+			"failed_count":     1,
+			"score_percentage": float64(75),
+		},
+		map[string]interface{}{
+			"id":               "wct-id-2",
+			"name":             "Not evaluated",
+			"passed_count":     0,
+			"failed_count":     0,
+			"score_percentage": float64(0),
+		},
+	}
+
+	var categories = []wiz.SecurityCategory{
+		{
+			ID:   "wct-id-1",
+			Name: "AM Asset Management",
+			Analytics: &wiz.SecurityCategoryAnalytics{
+				PassedCount: 3,
+				FailedCount: 1,
+			},
+		},
+		{
+			ID:   "wct-id-2",
+			Name: "Not evaluated",
+		},
+	}
+
+	passed, failed, flattened := flattenCompliancePosture(ctx, categories)
+
+	if passed != 3 || failed != 1 {
+		t.Fatalf("Got passed/failed: %d/%d Expected: 3/1", passed, failed)
+	}
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			flattened,
+			expected,
+		)
+	}
+}
+
+func TestCompliancePostureScoreNoEvaluations(t *testing.T) {
+	if score := compliancePostureScore(0, 0); score != 0 {
+		t.Fatalf("Got: %f Expected: 0", score)
+	}
+}
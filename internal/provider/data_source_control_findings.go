@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// controlFindingsPageSize is the largest number of issues read per request
+const controlFindingsPageSize = 500
+
+func dataSourceWizControlFindings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the issues raised by a control, to check that a new control flags the expected entities after apply. Controls are evaluated asynchronously, so a control that was just created may not have issues yet.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"control_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The control identifier.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"project_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Limit the findings to the issues of this project.",
+				ValidateDiagFunc: utils.ValidateUUID,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Limit the findings to issues of this severity.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.Severity,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.Severity,
+						false,
+					),
+				),
+			},
+			"max_results": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "Maximum number of findings to return. Issues are read in pages of up to 500.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IntBetween(1, 10000),
+				),
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of issues matching the search, including those beyond `max_results`.",
+			},
+			"findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Up to `max_results` issues raised by the control.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issue_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The issue identifier.",
+						},
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the flagged entity.",
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The graph type of the flagged entity.",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the flagged entity.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The issue severity.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The issue status.",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizControlFindingsRead,
+	}
+}
+
+// ReadControlFindings struct
+type ReadControlFindings struct {
+	Issues wiz.IssueConnection `json:"issuesV2"`
+}
+
+// ControlFindingFilters struct -- the issue filters used by wiz_control_findings
+type ControlFindingFilters struct {
+	SourceControl []string `json:"sourceControl"`
+	Project       []string `json:"project,omitempty"`
+	Severity      []string `json:"severity,omitempty"`
+}
+
+func dataSourceWizControlFindingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizControlFindingsRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("control_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("project_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("severity")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("max_results")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query controlFindings (
+	    $first: Int
+	    $after: String
+	    $filterBy: IssueFilters
+	){
+	    issuesV2(
+	        first: $first
+	        after: $after
+	        filterBy: $filterBy
+	    ) {
+	        nodes {
+	            id
+	            severity
+	            status
+	            entitySnapshot {
+	                id
+	                type
+	                name
+	            }
+	        }
+	        pageInfo {
+	            endCursor
+	            hasNextPage
+	        }
+	        totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	maxResults := d.Get("max_results").(int)
+	filterBy := &ControlFindingFilters{
+		SourceControl: []string{d.Get("control_id").(string)},
+	}
+	if projectID, ok := d.GetOk("project_id"); ok {
+		filterBy.Project = []string{projectID.(string)}
+	}
+	if severity, ok := d.GetOk("severity"); ok {
+		filterBy.Severity = []string{severity.(string)}
+	}
+	vars := &internal.QueryVariables{}
+	vars.First = min(maxResults, controlFindingsPageSize)
+	vars.FilterBy = filterBy
+
+	// process the request
+	// enough pages are read to reach max_results, the last page may hold more issues than needed
+	maxPages := (maxResults + vars.First - 1) / vars.First
+	data := &ReadControlFindings{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "control_findings", "read", maxPages)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	findings, totalCount := flattenControlFindings(ctx, allData, maxResults)
+
+	// set the data source parameters
+	err := d.Set("findings", findings)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("total_count", totalCount)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenControlFindings returns up to maxResults findings from the pages of issues, and the total number of matching issues
+func flattenControlFindings(ctx context.Context, pages []interface{}, maxResults int) (findings []interface{}, totalCount int) {
+	tflog.Info(ctx, "flattenControlFindings called...")
+
+	findings = make([]interface{}, 0)
+	for _, a := range pages {
+		issues := a.(*ReadControlFindings).Issues
+		totalCount = issues.TotalCount
+		for _, b := range issues.Nodes {
+			if len(findings) == maxResults {
+				return findings, totalCount
+			}
+			tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+			finding := map[string]interface{}{
+				"issue_id": b.ID,
+				"severity": b.Severity,
+				"status":   b.Status,
+			}
+			if b.EntitySnapshot != nil {
+				finding["entity_id"] = b.EntitySnapshot.ID
+				finding["entity_type"] = b.EntitySnapshot.Type
+				finding["entity_name"] = b.EntitySnapshot.Name
+			}
+			findings = append(findings, finding)
+		}
+	}
+	return findings, totalCount
+}
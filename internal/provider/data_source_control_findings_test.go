@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenControlFindings(t *testing.T) {
+	ctx := context.Background()
+
+	pages := []interface{}{
+		&ReadControlFindings{
+			Issues: wiz.IssueConnection{
+				Nodes: []*wiz.Issue{
+					{
+						ID:       "b6d4e5f0-1a2b-4c3d-8e9f-0a1b2c3d4e5f",
+						Severity: "HIGH",
+						Status:   "OPEN",
+						EntitySnapshot: &wiz.IssueEntitySnapshot{
+							ID:   "ee25cc95-82b0-4543-8934-5bc655b86786",
+							Type: "BUCKET",
+							Name: "public-bucket",
+						},
+					},
+					{
+						ID:       "0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+						Severity: "MEDIUM",
+						Status:   "RESOLVED",
+					},
+				},
+				TotalCount: 3,
+			},
+		},
+		&ReadControlFindings{
+			Issues: wiz.IssueConnection{
+				Nodes: []*wiz.Issue{
+					{
+						ID:       "3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+						Severity: "LOW",
+						Status:   "OPEN",
+					},
+				},
+				TotalCount: 3,
+			},
+		},
+	}
+
+	findings, totalCount := flattenControlFindings(ctx, pages, 2)
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"issue_id":    "b6d4e5f0-1a2b-4c3d-8e9f-0a1b2c3d4e5f",
+			"severity":    "HIGH",
+			"status":      "OPEN",
+			"entity_id":   "ee25cc95-82b0-4543-8934-5bc655b86786",
+			"entity_type": "BUCKET",
+			"entity_name": "public-bucket",
+		},
+		map[string]interface{}{
+			"issue_id": "0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+			"severity": "MEDIUM",
+			"status":   "RESOLVED",
+		},
+	}
+
+	if !reflect.DeepEqual(findings, expected) || totalCount != 3 {
+		t.Fatalf(
+			"Got:\n\n%#v %d\n\nExpected:\n\n%#v %d\n",
+			findings, totalCount,
+			expected, 3,
+		)
+	}
+}
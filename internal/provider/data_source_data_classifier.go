@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizDataClassifier() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the details for a Wiz data classifier by name. Use this to reference built-in classifiers without hardcoding their identifiers.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Wiz internal identifier for the data classifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The exact name of the data classifier.",
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data finding category of the classifier.",
+			},
+			"builtin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indication whether the classifier is built-in or custom.",
+			},
+		},
+		ReadContext: dataSourceWizDataClassifierRead,
+	}
+}
+
+// ReadDataClassifiers struct
+type ReadDataClassifiers struct {
+	DataClassifiers wiz.DataClassifierConnection `json:"dataClassifiers"`
+}
+
+func dataSourceWizDataClassifierRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizDataClassifierRead called...")
+
+	// define the graphql query
+	query := `query dataClassifiers (
+	    $first: Int
+	    $after: String
+	    $filterBy: DataClassifierFilters
+	){
+	    dataClassifiers(
+	        first: $first
+	        after: $after
+	        filterBy: $filterBy
+	    ) {
+	        nodes {
+	            id
+	            name
+	            category
+	            builtin
+	        }
+	        pageInfo {
+	            endCursor
+	            hasNextPage
+	        }
+	        totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = 500
+	vars.FilterBy = &wiz.DataClassifierFilters{
+		Search: d.Get("name").(string),
+	}
+
+	// process the request
+	data := &ReadDataClassifiers{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "data_classifiers", "read", 0)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	classifier, findDiags := findDataClassifier(ctx, d.Get("name").(string), allData)
+	diags = append(diags, findDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id and resource parameters
+	d.SetId(classifier.ID)
+	err := d.Set("category", classifier.Category)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("builtin", classifier.Builtin)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// findDataClassifier returns the single classifier whose name matches exactly
+// the search filter is a partial match, so the results are matched again client side
+func findDataClassifier(ctx context.Context, name string, classifiers []interface{}) (*wiz.DataClassifier, diag.Diagnostics) {
+	tflog.Info(ctx, "findDataClassifier called...")
+
+	var matches []*wiz.DataClassifier
+	for _, a := range classifiers {
+		for _, b := range a.(*ReadDataClassifiers).DataClassifiers.Nodes {
+			tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+			if b.Name == name {
+				matches = append(matches, b)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Data classifier not found",
+			Detail:   fmt.Sprintf("No data classifier was found with name %q.", name),
+		}}
+	default:
+		var ids []string
+		for _, c := range matches {
+			ids = append(ids, c.ID)
+		}
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Ambiguous data classifier name",
+			Detail:   fmt.Sprintf("%d data classifiers were found with name %q: %s", len(matches), name, strings.Join(ids, ", ")),
+		}}
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFindDataClassifier(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.DataClassifier{
+		ID:       "f1b4b6a5-0a5b-4b1a-8b1e-6c8f0d1c2b3a",
+		Name:     "Credit Card Number",
+		Category: "FINANCIAL",
+		Builtin:  true,
+	}
+
+	var classifiers = []interface{}{
+		&ReadDataClassifiers{
+			DataClassifiers: wiz.DataClassifierConnection{
+				Nodes: []*wiz.DataClassifier{
+					{
+						ID:       "a9c3d2e1-7b6a-4c5d-9e8f-0a1b2c3d4e5f",
+						Name:     "Credit Card Number (Custom)",
+						Category: "FINANCIAL",
+					},
+					expected,
+				},
+			},
+		},
+	}
+
+	classifier, diags := findDataClassifier(ctx, "Credit Card Number", classifiers)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+	if !reflect.DeepEqual(classifier, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			classifier,
+			expected,
+		)
+	}
+
+	// a second page with the same name makes the lookup ambiguous
+	classifiers = append(classifiers, &ReadDataClassifiers{
+		DataClassifiers: wiz.DataClassifierConnection{
+			Nodes: []*wiz.DataClassifier{
+				{
+					ID:   "0b8e6b2c-4d3a-4f1e-9c7b-5a6d8e9f0a1b",
+					Name: "Credit Card Number",
+				},
+			},
+		},
+	})
+	_, diags = findDataClassifier(ctx, "Credit Card Number", classifiers)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error for an ambiguous name")
+	}
+
+	_, diags = findDataClassifier(ctx, "Unknown", classifiers)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error for an unknown name")
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizEntity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the details of a Wiz graph entity (e.g. a virtual machine, bucket or user) by its Wiz identifier, or by its cloud provider identifier (e.g. an AWS ARN). Fails when the entity does not exist.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The Wiz identifier of the entity. Exactly one of `id` and `external_id` must be set.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+				ExactlyOneOf:     []string{"id", "external_id"},
+			},
+			"external_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The identifier of the entity at its cloud provider, e.g. an AWS ARN, Azure resource ID or GCP resource name. Requires `type`. The lookup fails when no entity or more than one entity of the type has this identifier.",
+				ExactlyOneOf: []string{"id", "external_id"},
+				RequiredWith: []string{"type"},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The graph entity type (e.g. `VIRTUAL_MACHINE`). When set, the lookup fails if the entity has a different type.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity name.",
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cloud platform of the entity (e.g. `AWS`). Empty for entities that do not belong to a cloud platform.",
+			},
+			"project_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The projects the entity belongs to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The cloud tags of the entity.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizEntityRead,
+	}
+}
+
+// ReadGraphEntityPayload struct
+type ReadGraphEntityPayload struct {
+	GraphEntity *wiz.GraphEntity `json:"graphEntity"`
+}
+
+func dataSourceWizEntityRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizEntityRead called...")
+
+	// define the graphql query
+	query := `query graphEntity ($id: ID!){
+	    graphEntity(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        type
+	        properties
+	        projects {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Get("id").(string)
+	if externalID, ok := d.GetOk("external_id"); ok && vars.ID == "" {
+		entityID, searchDiags := searchEntityByExternalID(ctx, m, d.Get("type").(string), externalID.(string))
+		diags = append(diags, searchDiags...)
+		if len(diags) > 0 {
+			return diags
+		}
+		vars.ID = entityID
+	}
+
+	// process the request
+	data := &ReadGraphEntityPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "entity", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	if data.GraphEntity == nil || data.GraphEntity.ID == "" {
+		return append(diags, diag.Errorf("entity %s not found", vars.ID)...)
+	}
+	if entityType, ok := d.GetOk("type"); ok && entityType.(string) != data.GraphEntity.Type {
+		return append(diags, diag.Errorf("entity %s is of type %s, expected %s", vars.ID, data.GraphEntity.Type, entityType)...)
+	}
+
+	// set the id
+	d.SetId(data.GraphEntity.ID)
+
+	// set the data source parameters
+	err := d.Set("type", data.GraphEntity.Type)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	externalID, _ := data.GraphEntity.Properties["externalId"].(string)
+	err = d.Set("external_id", externalID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("name", data.GraphEntity.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	cloudProvider, _ := data.GraphEntity.Properties["cloudPlatform"].(string)
+	err = d.Set("cloud_provider", cloudProvider)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	projectIDs := make([]string, 0, len(data.GraphEntity.Projects))
+	for _, p := range data.GraphEntity.Projects {
+		projectIDs = append(projectIDs, p.ID)
+	}
+	sort.Strings(projectIDs)
+	err = d.Set("project_ids", projectIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("tags", flattenGraphEntityTags(data.GraphEntity.Properties))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// SearchGraphEntitiesPayload struct
+type SearchGraphEntitiesPayload struct {
+	GraphSearch wiz.GraphSearchResultConnection `json:"graphSearch"`
+}
+
+// searchEntityByExternalID returns the Wiz identifier of the entity of a type with a cloud provider identifier
+func searchEntityByExternalID(ctx context.Context, m interface{}, entityType string, externalID string) (string, diag.Diagnostics) {
+	tflog.Info(ctx, "searchEntityByExternalID called...")
+
+	// define the graphql query
+	query := `query searchEntityByExternalId ($query: GraphEntityQueryInput, $first: Int){
+	    graphSearch(
+	        query: $query
+	        first: $first
+	        quick: true
+	    ) {
+	        nodes {
+	            entities {
+	                id
+	                name
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	// two results are enough to tell an ambiguous identifier apart
+	vars := &internal.QueryVariables{}
+	vars.First = 2
+	vars.Query = &wiz.GraphEntityQueryInput{
+		Type: []string{entityType},
+		Where: map[string]interface{}{
+			"externalId": map[string]interface{}{
+				"EQUALS": []string{externalID},
+			},
+		},
+	}
+
+	// process the request
+	data := &SearchGraphEntitiesPayload{}
+	diags := client.ProcessRequest(ctx, m, vars, data, query, "entity", "read")
+	if len(diags) > 0 {
+		return "", diags
+	}
+
+	return externalIDMatch(entityType, externalID, data.GraphSearch.Nodes)
+}
+
+// externalIDMatch returns the identifier of the only entity in the search results
+func externalIDMatch(entityType string, externalID string, nodes []*wiz.GraphSearchResult) (string, diag.Diagnostics) {
+	var ids []string
+	for _, a := range nodes {
+		for _, b := range a.Entities {
+			ids = append(ids, b.ID)
+		}
+	}
+	ids = utils.Unique(ids)
+	switch len(ids) {
+	case 0:
+		return "", diag.Errorf("no %s entity with external_id %s found in the Wiz inventory", entityType, externalID)
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", diag.Errorf("more than one %s entity has external_id %s (%s), use id to select one", entityType, externalID, strings.Join(ids, ", "))
+	}
+}
+
+// flattenGraphEntityTags returns the tags property of an entity as strings, tag values that are not strings are formatted
+func flattenGraphEntityTags(properties map[string]interface{}) map[string]interface{} {
+	var output = make(map[string]interface{})
+	tags, _ := properties["tags"].(map[string]interface{})
+	for k, v := range tags {
+		switch t := v.(type) {
+		case string:
+			output[k] = t
+		case nil:
+			output[k] = ""
+		default:
+			output[k] = fmt.Sprintf("%v", t)
+		}
+	}
+	return output
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenGraphEntityTags(t *testing.T) {
+	properties := map[string]interface{}{
+		"cloudPlatform": "AWS",
+		"tags": map[string]interface{}{
+			"owner":    "platform",
+			"cost":     float64(42),
+			"archived": false,
+			"empty":    nil,
+		},
+	}
+
+	expected := map[string]interface{}{
+		"owner":    "platform",
+		"cost":     "42",
+		"archived": "false",
+		"empty":    "",
+	}
+
+	tags := flattenGraphEntityTags(properties)
+	if !reflect.DeepEqual(expected, tags) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			tags,
+			expected,
+		)
+	}
+
+	tags = flattenGraphEntityTags(map[string]interface{}{})
+	if !reflect.DeepEqual(map[string]interface{}{}, tags) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			tags,
+			map[string]interface{}{},
+		)
+	}
+}
+
+func TestExternalIDMatch(t *testing.T) {
+	externalID := "arn:aws:s3:::example-bucket"
+	match := []*wiz.GraphSearchResult{
+		{
+			Entities: []wiz.GraphEntity{
+				{ID: "ee25cc95-82b0-4543-8934-5bc655b86786", Name: "example-bucket"},
+			},
+		},
+	}
+
+	id, diags := externalIDMatch("BUCKET", externalID, match)
+	if len(diags) != 0 || id != "ee25cc95-82b0-4543-8934-5bc655b86786" {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected:\n\n%#v\n", id, diags, "ee25cc95-82b0-4543-8934-5bc655b86786")
+	}
+
+	// no match
+	_, diags = externalIDMatch("BUCKET", externalID, nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "no BUCKET entity with external_id arn:aws:s3:::example-bucket found") {
+		t.Fatalf("Expected a not found error, got: %#v", diags)
+	}
+
+	// more than one match
+	ambiguous := append(match, &wiz.GraphSearchResult{
+		Entities: []wiz.GraphEntity{
+			{ID: "e7f6542c-81f6-43cf-af48-bdd77f09650d", Name: "example-bucket"},
+		},
+	})
+	_, diags = externalIDMatch("BUCKET", externalID, ambiguous)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "(e7f6542c-81f6-43cf-af48-bdd77f09650d, ee25cc95-82b0-4543-8934-5bc655b86786)") {
+		t.Fatalf("Expected an ambiguity error, got: %#v", diags)
+	}
+}
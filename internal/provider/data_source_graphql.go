@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// graphQLMutationKeyword matches the mutation keyword anywhere in a graphql document
+var graphQLMutationKeyword = regexp.MustCompile(`\bmutation\b`)
+
+func dataSourceWizGraphQL() *schema.Resource {
+	return &schema.Resource{
+		Description: "**Advanced, unsupported.** Run a raw GraphQL query against the Wiz API and return the response data as JSON. Use this to read fields the provider does not model yet. Queries are sent as written; the Wiz API schema may change without notice, and mutations are rejected.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the query.  This is a sha1 hash of the query and variables.",
+			},
+			"query": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The GraphQL query. Documents containing the `mutation` keyword are rejected.",
+				ValidateDiagFunc: validateGraphQLQuery,
+			},
+			"variables": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The query variables, as a JSON object.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `data` of the response, as normalized JSON. Decode it with `jsondecode()`.",
+			},
+		},
+		ReadContext: dataSourceWizGraphQLRead,
+	}
+}
+
+// validateGraphQLQuery rejects graphql documents that contain a mutation
+func validateGraphQLQuery(i interface{}, path cty.Path) diag.Diagnostics {
+	if graphQLMutationKeyword.MatchString(i.(string)) {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "Mutations are not allowed",
+				Detail:        "wiz_graphql only runs queries. Remove the mutation from the document.",
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+func dataSourceWizGraphQLRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizGraphQLRead called...")
+
+	// validate the query again, the schema validation is skipped when the query is unknown at plan time
+	query := d.Get("query").(string)
+	if validateDiags := validateGraphQLQuery(query, cty.GetAttrPath("query")); validateDiags.HasError() {
+		return append(diags, validateDiags...)
+	}
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the query and variables
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("query")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("variables")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// process the request
+	result, requestDiags := processRawGraphQLRequest(ctx, m, query, d.Get("variables").(string), "graphql", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	err := d.Set("result", result)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// processRawGraphQLRequest runs a raw graphql document and returns the response data as normalized JSON
+// the variables are sent as given rather than wrapped in an input object, operation labels the request in stats and diagnostics
+func processRawGraphQLRequest(ctx context.Context, m interface{}, query string, variables string, resourceType string, operation string) (string, diag.Diagnostics) {
+	tflog.Info(ctx, "processRawGraphQLRequest called...")
+
+	// populate the graphql variables
+	vars := make(map[string]interface{})
+	if variables != "" {
+		err := json.Unmarshal([]byte(variables), &vars)
+		if err != nil {
+			return "", diag.FromErr(fmt.Errorf("variables must be a JSON object: %w", err))
+		}
+	}
+
+	// process the request
+	data := json.RawMessage{}
+	diags := client.ProcessRawRequest(ctx, m, vars, &data, query, resourceType, operation)
+	if len(diags) > 0 {
+		return "", diags
+	}
+
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+	result, err := utils.NormalizeJSON(string(data))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	return result, diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+func TestValidateGraphQLQuery(t *testing.T) {
+	allowed := []string{
+		`query { viewer { id } }`,
+		`query schema { __schema { mutationType { name } } }`,
+	}
+	for _, q := range allowed {
+		if diags := validateGraphQLQuery(q, cty.Path{}); diags.HasError() {
+			t.Fatalf("Expected %q to be allowed, got: %#v", q, diags)
+		}
+	}
+
+	rejected := []string{
+		`mutation { deleteProject(input: {id: "x"}) { _stub } }`,
+		"query { viewer { id } }\nmutation DeleteUser { deleteUser(input: {id: \"x\"}) { _stub } }",
+	}
+	for _, q := range rejected {
+		if diags := validateGraphQLQuery(q, cty.Path{}); !diags.HasError() {
+			t.Fatalf("Expected %q to be rejected", q)
+		}
+	}
+}
+
+func TestGraphQLReadRejectsMutation(t *testing.T) {
+	ctx := context.Background()
+
+	// a query computed from other resources is not validated at plan time, so the read must not send it
+	requests := 0
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requests++
+				return nil, http.ErrHandlerTimeout
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceWizGraphQL().Schema, map[string]interface{}{
+		"query": `mutation { deleteProject(input: {id: "x"}) { _stub } }`,
+	})
+
+	diags := dataSourceWizGraphQLRead(ctx, d, m)
+	if !diags.HasError() || diags[0].Summary != "Mutations are not allowed" {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a mutation error\n", diags)
+	}
+	if requests != 0 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			requests,
+			0,
+		)
+	}
+}
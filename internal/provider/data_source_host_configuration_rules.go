@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
@@ -121,6 +122,23 @@ func dataSourceWizHostConfigurationRules() *schema.Resource {
 					},
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizHostConfigurationRuleRead,
 	}
@@ -260,6 +278,7 @@ func dataSourceWizHostConfigurationRuleRead(ctx context.Context, d *schema.Resou
 	}
 
 	hostConfigurationRules := flattenHostConfigurationRules(ctx, &data.HostConfigurationRules.Nodes)
+	utils.SortFlattenedList(hostConfigurationRules, d.Get("order_by").(string))
 	if err := d.Set("host_configuration_rules", hostConfigurationRules); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
@@ -141,6 +141,23 @@ func dataSourceWizKubernetesClusters() *schema.Resource {
 					},
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizKubernetesClustersRead,
 	}
@@ -259,6 +276,7 @@ func dataSourceWizKubernetesClustersRead(ctx context.Context, d *schema.Resource
 	}
 
 	clusters := flattenClusters(ctx, allData)
+	utils.SortFlattenedList(clusters, d.Get("order_by").(string))
 
 	if err := d.Set("kubernetes_clusters", clusters); err != nil {
 		return append(diags, diag.FromErr(err)...)
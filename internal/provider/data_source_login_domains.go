@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizLoginDomains() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the email domains configured for SSO on the SAML identity providers of the tenant, e.g. to check that a new user's email falls under a managed domain before creating the user.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The configured domains, sorted by domain. A domain handled by several identity providers is listed once per identity provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email domain, in lower case.",
+						},
+						"idp_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the SAML identity provider handling the domain.",
+						},
+						"idp_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the SAML identity provider handling the domain.",
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizLoginDomainsRead,
+	}
+}
+
+// ReadSAMLIdentityProviders struct
+type ReadSAMLIdentityProviders struct {
+	SAMLIdentityProviders wiz.SAMLIdentityProviderConnection `json:"samlIdentityProviders"`
+}
+
+func dataSourceWizLoginDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizLoginDomainsRead called...")
+
+	// process the request
+	identityProviders, requestDiags := readSAMLIdentityProviders(ctx, m)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// the domains belong to the tenant, so the id is fixed
+	d.SetId("login_domains")
+
+	err := d.Set("domains", flattenLoginDomains(identityProviders))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// readSAMLIdentityProviders returns every SAML identity provider in the tenant, with its domains and group mappings
+func readSAMLIdentityProviders(ctx context.Context, m interface{}) ([]*wiz.SAMLIdentityProvider, diag.Diagnostics) {
+	tflog.Info(ctx, "readSAMLIdentityProviders called...")
+
+	// define the graphql query
+	query := `query samlIdentityProviders (
+	    $first: Int
+	    $after: String
+	){
+	    samlIdentityProviders(
+	        first: $first
+	        after: $after
+	    ) {
+	        nodes {
+	            id
+	            name
+	            domains
+	            groupMapping {
+	                providerGroupId
+	                role {
+	                    id
+	                }
+	                projects {
+	                    id
+	                }
+	            }
+	        }
+	        pageInfo {
+	            hasNextPage
+	            endCursor
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = 100
+
+	// process the request
+	data := &ReadSAMLIdentityProviders{}
+	diags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "saml_idps", "read", 0)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var identityProviders []*wiz.SAMLIdentityProvider
+	for _, a := range allData {
+		identityProviders = append(identityProviders, a.(*ReadSAMLIdentityProviders).SAMLIdentityProviders.Nodes...)
+	}
+	return identityProviders, diags
+}
+
+// flattenLoginDomains returns one entry per domain and identity provider, sorted by domain and then by identity provider name
+func flattenLoginDomains(identityProviders []*wiz.SAMLIdentityProvider) []interface{} {
+	type loginDomain struct {
+		domain  string
+		idpID   string
+		idpName string
+	}
+	var domains []loginDomain
+	seen := make(map[loginDomain]bool)
+	for _, a := range identityProviders {
+		for _, b := range a.Domains {
+			domain := loginDomain{
+				domain:  strings.ToLower(strings.TrimSpace(b)),
+				idpID:   a.ID,
+				idpName: a.Name,
+			}
+			if domain.domain == "" || seen[domain] {
+				continue
+			}
+			seen[domain] = true
+			domains = append(domains, domain)
+		}
+	}
+	sort.SliceStable(domains, func(i, j int) bool {
+		if domains[i].domain != domains[j].domain {
+			return domains[i].domain < domains[j].domain
+		}
+		return domains[i].idpName < domains[j].idpName
+	})
+
+	var output = make([]interface{}, 0, len(domains))
+	for _, a := range domains {
+		output = append(output, map[string]interface{}{
+			"domain":   a.domain,
+			"idp_id":   a.idpID,
+			"idp_name": a.idpName,
+		})
+	}
+	return output
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenLoginDomains(t *testing.T) {
+	identityProviders := []*wiz.SAMLIdentityProvider{
+		{
+			ID:      "okta-id",
+			Name:    "okta",
+			Domains: []string{"Example.com", "example.org", " example.com "},
+		},
+		{
+			ID:      "azure-id",
+			Name:    "azure",
+			Domains: []string{"example.com", ""},
+		},
+		{
+			ID:   "google-id",
+			Name: "google",
+		},
+	}
+
+	var expected = []interface{}{
+		map[string]interface{}{
+			"domain":   "example.com",
+			"idp_id":   "azure-id",
+			"idp_name": "azure",
+		},
+		map[string]interface{}{
+			"domain":   "example.com",
+			"idp_id":   "okta-id",
+			"idp_name": "okta",
+		},
+		map[string]interface{}{
+			"domain":   "example.org",
+			"idp_id":   "okta-id",
+			"idp_name": "okta",
+		},
+	}
+
+	domains := flattenLoginDomains(identityProviders)
+
+	if !reflect.DeepEqual(expected, domains) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			domains,
+			expected,
+		)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
@@ -69,6 +70,23 @@ func dataSourceWizOrganizations() *schema.Resource {
 				Default:     500,
 				Description: "How many matches to return.",
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizOrganizationsRead,
 	}
@@ -140,6 +158,7 @@ func dataSourceWizOrganizationsRead(ctx context.Context, d *schema.ResourceData,
 		return append(diags, diag.FromErr(err)...)
 	}
 	organizations := flattenOrganizations(ctx, &data.CloudOrganizations.Nodes)
+	utils.SortFlattenedList(organizations, d.Get("order_by").(string))
 	if err := d.Set("organizations", organizations); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
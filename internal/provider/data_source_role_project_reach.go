@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizRoleProjectReach() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the projects a role is granted on through the group mappings of all SAML identity providers, to answer access reviews such as \"where can `PROJECT_ADMIN` act\". Roles assigned to users directly are not included.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role, by identifier (e.g. `PROJECT_ADMIN`) or by name (e.g. `Project Admin`).",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the role.",
+			},
+			"project_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The projects the role is granted on by group mappings that list projects, sorted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"global": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a group mapping grants the role without projects, i.e. on all projects.",
+			},
+			"bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The group mappings that grant the role, sorted by identity provider name and group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idp_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the SAML identity provider.",
+						},
+						"idp_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the SAML identity provider.",
+						},
+						"provider_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provider group mapped to the role.",
+						},
+						"project_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The projects of the mapping, empty when the role is granted on all projects.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizRoleProjectReachRead,
+	}
+}
+
+func dataSourceWizRoleProjectReachRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizRoleProjectReachRead called...")
+
+	// resolve the role name to its identifier
+	roles, requestDiags := readUserRoles(ctx, m)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	role := d.Get("role").(string)
+	roleID, err := resolveRoleID(roles, role)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// process the request
+	identityProviders, requestDiags := readSAMLIdentityProviders(ctx, m)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(roleID)
+
+	projectIDs, global, bindings := flattenRoleProjectReach(identityProviders, roleID)
+	err = d.Set("role_id", roleID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("project_ids", projectIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("global", global)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("bindings", bindings)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// resolveRoleID returns the identifier of the role with the given identifier or name
+// identifiers take precedence, and a name shared by several roles is an error
+func resolveRoleID(roles []*wiz.UserRole, role string) (string, error) {
+	for _, b := range roles {
+		if b.ID == role {
+			return b.ID, nil
+		}
+	}
+	rolesMap, err := flattenRolesMap(roles, "all")
+	if err != nil {
+		return "", err
+	}
+	if id, ok := rolesMap[role]; ok {
+		return id.(string), nil
+	}
+	return "", fmt.Errorf("role %s not found", role)
+}
+
+// flattenRoleProjectReach returns the projects roleID is granted on, whether it is granted on all projects, and the group mappings that grant it
+func flattenRoleProjectReach(identityProviders []*wiz.SAMLIdentityProvider, roleID string) ([]string, bool, []interface{}) {
+	type binding struct {
+		idpID           string
+		idpName         string
+		providerGroupID string
+		projectIDs      []string
+	}
+
+	var bindings []binding
+	projects := make(map[string]bool)
+	global := false
+	for _, a := range identityProviders {
+		for _, b := range a.GroupMapping {
+			if b.Role.ID != roleID {
+				continue
+			}
+			projectIDs := make([]string, 0, len(b.Projects))
+			for _, c := range b.Projects {
+				projectIDs = append(projectIDs, c.ID)
+				projects[c.ID] = true
+			}
+			sort.Strings(projectIDs)
+			if len(projectIDs) == 0 {
+				global = true
+			}
+			bindings = append(bindings, binding{
+				idpID:           a.ID,
+				idpName:         a.Name,
+				providerGroupID: b.ProviderGroupID,
+				projectIDs:      projectIDs,
+			})
+		}
+	}
+	sort.SliceStable(bindings, func(i, j int) bool {
+		if bindings[i].idpName != bindings[j].idpName {
+			return bindings[i].idpName < bindings[j].idpName
+		}
+		return bindings[i].providerGroupID < bindings[j].providerGroupID
+	})
+
+	projectIDs := make([]string, 0, len(projects))
+	for id := range projects {
+		projectIDs = append(projectIDs, id)
+	}
+	sort.Strings(projectIDs)
+
+	var output = make([]interface{}, 0, len(bindings))
+	for _, a := range bindings {
+		output = append(output, map[string]interface{}{
+			"idp_id":            a.idpID,
+			"idp_name":          a.idpName,
+			"provider_group_id": a.providerGroupID,
+			"project_ids":       a.projectIDs,
+		})
+	}
+	return projectIDs, global, output
+}
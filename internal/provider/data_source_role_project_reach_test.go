@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestResolveRoleID(t *testing.T) {
+	roles := []*wiz.UserRole{
+		{ID: "PROJECT_ADMIN", Name: "Project Admin"},
+		{ID: "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b", Name: "PROJECT_READER"},
+		{ID: "PROJECT_READER", Name: "Project Reader"},
+	}
+
+	var tests = map[string]string{
+		"PROJECT_ADMIN":  "PROJECT_ADMIN",
+		"Project Admin":  "PROJECT_ADMIN",
+		"PROJECT_READER": "PROJECT_READER",
+	}
+
+	for role, expected := range tests {
+		roleID, err := resolveRoleID(roles, role)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if roleID != expected {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				roleID,
+				expected,
+			)
+		}
+	}
+
+	_, err := resolveRoleID(roles, "Global Admin")
+	if err == nil || err.Error() != "role Global Admin not found" {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a not found error\n", err)
+	}
+}
+
+func TestFlattenRoleProjectReach(t *testing.T) {
+	identityProviders := []*wiz.SAMLIdentityProvider{
+		{
+			ID:   "okta-id",
+			Name: "okta",
+			GroupMapping: []*wiz.SAMLGroupMapping{
+				{
+					ProviderGroupID: "platform",
+					Role:            wiz.UserRole{ID: "PROJECT_ADMIN"},
+					Projects: []wiz.Project{
+						{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+						{ID: "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b"},
+					},
+				},
+				{
+					ProviderGroupID: "auditors",
+					Role:            wiz.UserRole{ID: "GLOBAL_READER"},
+				},
+			},
+		},
+		{
+			ID:   "azure-id",
+			Name: "azure",
+			GroupMapping: []*wiz.SAMLGroupMapping{
+				{
+					ProviderGroupID: "data",
+					Role:            wiz.UserRole{ID: "PROJECT_ADMIN"},
+					Projects: []wiz.Project{
+						{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+					},
+				},
+			},
+		},
+	}
+
+	expectedProjectIDs := []string{
+		"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b",
+		"ee25cc95-82b0-4543-8934-5bc655b86786",
+	}
+	expectedBindings := []interface{}{
+		map[string]interface{}{
+			"idp_id":            "azure-id",
+			"idp_name":          "azure",
+			"provider_group_id": "data",
+			"project_ids":       []string{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+		},
+		map[string]interface{}{
+			"idp_id":            "okta-id",
+			"idp_name":          "okta",
+			"provider_group_id": "platform",
+			"project_ids":       []string{"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b", "ee25cc95-82b0-4543-8934-5bc655b86786"},
+		},
+	}
+
+	projectIDs, global, bindings := flattenRoleProjectReach(identityProviders, "PROJECT_ADMIN")
+	if !reflect.DeepEqual(expectedProjectIDs, projectIDs) || global || !reflect.DeepEqual(expectedBindings, bindings) {
+		t.Fatalf(
+			"Got:\n\n%#v\n%#v\n%#v\n\nExpected:\n\n%#v\n%#v\n%#v\n",
+			projectIDs,
+			global,
+			bindings,
+			expectedProjectIDs,
+			false,
+			expectedBindings,
+		)
+	}
+
+	projectIDs, global, _ = flattenRoleProjectReach(identityProviders, "GLOBAL_READER")
+	if len(projectIDs) != 0 || !global {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected a global binding without projects\n", projectIDs, global)
+	}
+}
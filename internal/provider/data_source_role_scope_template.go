@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+// builtinRoleScopeTemplates returns the scope templates shipped with the provider, keyed by name
+func builtinRoleScopeTemplates() map[string][]string {
+	var readOnly []string
+	for _, scope := range internal.ServiceAccountScopes {
+		if strings.HasPrefix(scope, "read:") && scope != "read:all" {
+			readOnly = append(readOnly, scope)
+		}
+	}
+	return map[string][]string{
+		"read-only":     readOnly,
+		"project-admin": append([]string{"admin:projects"}, readOnly...),
+	}
+}
+
+func dataSourceWizRoleScopeTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Expand a named scope template to a concrete list of scopes, e.g. for the `scopes` of `wiz_service_account`, so the same scope bundle is not copied across configurations. Templates are defined in the provider and in the configuration; no API request is made.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: fmt.Sprintf(
+					"The template to expand, either a built-in template or one of the `custom_template` blocks. `read-only` includes every `read:` scope except `read:all`, `project-admin` adds `admin:projects` to `read-only`.\n    - Built-in templates: %s",
+					utils.SliceOfStringToMDUList(
+						[]string{
+							"project-admin",
+							"read-only",
+						},
+					),
+				),
+			},
+			"custom_template": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "User-defined templates. A template can include other templates, built-in or custom.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The template name. Must not be the name of a built-in template.",
+						},
+						"scopes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The scopes of the template.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(
+									validation.StringInSlice(
+										internal.ServiceAccountScopes,
+										false,
+									),
+								),
+							},
+						},
+						"templates": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The names of templates whose scopes are included.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The scopes of the template, sorted and without duplicates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizRoleScopeTemplateRead,
+	}
+}
+
+// roleScopeTemplate struct -- a user-defined scope template
+type roleScopeTemplate struct {
+	scopes    []string
+	templates []string
+}
+
+func dataSourceWizRoleScopeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizRoleScopeTemplateRead called...")
+
+	// collect the user-defined templates
+	custom := make(map[string]roleScopeTemplate)
+	for _, a := range d.Get("custom_template").([]interface{}) {
+		template := a.(map[string]interface{})
+		name := template["name"].(string)
+		if _, ok := custom[name]; ok {
+			return append(diags, diag.Errorf("custom_template %s is defined more than once", name)...)
+		}
+		custom[name] = roleScopeTemplate{
+			scopes:    utils.ConvertListToString(template["scopes"].([]interface{})),
+			templates: utils.ConvertListToString(template["templates"].([]interface{})),
+		}
+	}
+
+	name := d.Get("name").(string)
+	scopes, err := expandRoleScopeTemplate(name, builtinRoleScopeTemplates(), custom)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// the scopes only depend on the configuration, so the template name identifies the data
+	d.SetId(name)
+
+	err = d.Set("scopes", scopes)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// expandRoleScopeTemplate returns the sorted, unique scopes of a template, including the scopes of the templates it includes
+func expandRoleScopeTemplate(name string, builtin map[string][]string, custom map[string]roleScopeTemplate) ([]string, error) {
+	for customName := range custom {
+		if _, ok := builtin[customName]; ok {
+			return nil, fmt.Errorf("custom_template %s has the name of a built-in template", customName)
+		}
+	}
+
+	scopes := make(map[string]bool)
+	var expand func(name string, path []string) error
+	expand = func(name string, path []string) error {
+		for i, a := range path {
+			if a == name {
+				return fmt.Errorf("template %s includes itself: %s", name, strings.Join(append(path[i:], name), " -> "))
+			}
+		}
+		if builtinScopes, ok := builtin[name]; ok {
+			for _, scope := range builtinScopes {
+				scopes[scope] = true
+			}
+			return nil
+		}
+		template, ok := custom[name]
+		if !ok {
+			return fmt.Errorf("template %s is not a built-in template or a custom_template", name)
+		}
+		for _, scope := range template.scopes {
+			scopes[scope] = true
+		}
+		for _, included := range template.templates {
+			err := expand(included, append(path, name))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := expand(name, nil)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]string, 0, len(scopes))
+	for scope := range scopes {
+		output = append(output, scope)
+	}
+	sort.Strings(output)
+	return output, nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandRoleScopeTemplate(t *testing.T) {
+	builtin := map[string][]string{
+		"read-only": {"read:projects", "read:issues"},
+	}
+	custom := map[string]roleScopeTemplate{
+		"triage": {
+			scopes:    []string{"update:issues", "write:issues"},
+			templates: []string{"read-only"},
+		},
+		"security": {
+			scopes:    []string{"read:issues", "admin:security_settings"},
+			templates: []string{"triage"},
+		},
+	}
+
+	expected := []string{
+		"admin:security_settings",
+		"read:issues",
+		"read:projects",
+		"update:issues",
+		"write:issues",
+	}
+
+	scopes, err := expandRoleScopeTemplate("security", builtin, custom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, scopes) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			scopes,
+			expected,
+		)
+	}
+
+	var errTests = []struct {
+		name     string
+		custom   map[string]roleScopeTemplate
+		expected string
+	}{
+		{
+			name:     "missing",
+			custom:   custom,
+			expected: "template missing is not a built-in template or a custom_template",
+		},
+		{
+			name: "a",
+			custom: map[string]roleScopeTemplate{
+				"a": {templates: []string{"b"}},
+				"b": {templates: []string{"a"}},
+			},
+			expected: "template a includes itself: a -> b -> a",
+		},
+		{
+			name: "read-only",
+			custom: map[string]roleScopeTemplate{
+				"read-only": {scopes: []string{"read:all"}},
+			},
+			expected: "custom_template read-only has the name of a built-in template",
+		},
+	}
+
+	for _, tc := range errTests {
+		_, err := expandRoleScopeTemplate(tc.name, builtin, tc.custom)
+		if err == nil || err.Error() != tc.expected {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				err,
+				tc.expected,
+			)
+		}
+	}
+}
+
+func TestBuiltinRoleScopeTemplates(t *testing.T) {
+	templates := builtinRoleScopeTemplates()
+	for name, scopes := range templates {
+		if len(scopes) == 0 {
+			t.Fatalf("Template %s has no scopes", name)
+		}
+		for _, scope := range scopes {
+			if scope == "read:all" {
+				t.Fatalf("Template %s includes read:all", name)
+			}
+		}
+	}
+	if templates["project-admin"][0] != "admin:projects" || len(templates["project-admin"]) != len(templates["read-only"])+1 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected admin:projects and the read-only scopes\n", templates["project-admin"])
+	}
+}
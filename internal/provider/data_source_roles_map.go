@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizRolesMap() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a map of role names to role identifiers for the roles in the tenant, e.g. to use `local.roles[\"Project Admin\"]` in group mappings. All roles are read with a single paged query.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"role_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "all",
+				Description: fmt.Sprintf(
+					"Which roles to return. Built-in roles have fixed identifiers such as `GLOBAL_ADMIN`, custom roles are identified by a UUID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.RoleType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.RoleType,
+						false,
+					),
+				),
+			},
+			"roles": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The role identifiers, keyed by role name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizRolesMapRead,
+	}
+}
+
+// ReadUserRoles struct
+type ReadUserRoles struct {
+	UserRoles wiz.UserRoleConnection `json:"userRoles"`
+}
+
+// readUserRoles returns every role in the tenant
+func readUserRoles(ctx context.Context, m interface{}) ([]*wiz.UserRole, diag.Diagnostics) {
+	tflog.Info(ctx, "readUserRoles called...")
+
+	// define the graphql query
+	query := `query userRoles (
+	    $first: Int
+	    $after: String
+	){
+	    userRoles(
+	        first: $first
+	        after: $after
+	    ) {
+	        nodes {
+	            id
+	            name
+	            scopes
+	        }
+	        pageInfo {
+	            hasNextPage
+	            endCursor
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = 100
+
+	// process the request
+	data := &ReadUserRoles{}
+	diags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "roles", "read", 0)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var roles []*wiz.UserRole
+	for _, a := range allData {
+		roles = append(roles, a.(*ReadUserRoles).UserRoles.Nodes...)
+	}
+	return roles, diags
+}
+
+func dataSourceWizRolesMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizRolesMapRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	h := sha1.New()
+	h.Write([]byte(utils.PrettyPrint(d.Get("role_type"))))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	// process the request
+	roles, requestDiags := readUserRoles(ctx, m)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	rolesMap, err := flattenRolesMap(roles, d.Get("role_type").(string))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("roles", rolesMap)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenRolesMap returns the identifiers of the roles of roleType keyed by name
+// two roles with the same name cannot be told apart in the map, so they are reported as an error
+func flattenRolesMap(roles []*wiz.UserRole, roleType string) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	for _, b := range roles {
+		custom := utils.IsUUID(b.ID)
+		if (roleType == "builtin" && custom) || (roleType == "custom" && !custom) {
+			continue
+		}
+		if id, ok := output[b.Name]; ok {
+			ids := []string{id.(string), b.ID}
+			sort.Strings(ids)
+			return nil, fmt.Errorf("roles %s and %s are both named %q", ids[0], ids[1], b.Name)
+		}
+		output[b.Name] = b.ID
+	}
+	return output, nil
+}
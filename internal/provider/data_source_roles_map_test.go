@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenRolesMap(t *testing.T) {
+	roles := []*wiz.UserRole{
+		{ID: "GLOBAL_ADMIN", Name: "Global Admin"},
+		{ID: "PROJECT_ADMIN", Name: "Project Admin"},
+		{ID: "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b", Name: "Security Reviewer"},
+	}
+
+	var tests = []struct {
+		roleType string
+		expected map[string]interface{}
+	}{
+		{
+			roleType: "all",
+			expected: map[string]interface{}{
+				"Global Admin":      "GLOBAL_ADMIN",
+				"Project Admin":     "PROJECT_ADMIN",
+				"Security Reviewer": "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b",
+			},
+		},
+		{
+			roleType: "builtin",
+			expected: map[string]interface{}{
+				"Global Admin":  "GLOBAL_ADMIN",
+				"Project Admin": "PROJECT_ADMIN",
+			},
+		},
+		{
+			roleType: "custom",
+			expected: map[string]interface{}{
+				"Security Reviewer": "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		rolesMap, err := flattenRolesMap(roles, tc.roleType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tc.expected, rolesMap) {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				rolesMap,
+				tc.expected,
+			)
+		}
+	}
+
+	roles = append(roles, &wiz.UserRole{ID: "9c1d7e2f-6a3b-4c8d-8e5f-0a7b3c9d2e1f", Name: "Project Admin"})
+	_, err := flattenRolesMap(roles, "all")
+	if err == nil || err.Error() != `roles 9c1d7e2f-6a3b-4c8d-8e5f-0a7b3c9d2e1f and PROJECT_ADMIN are both named "Project Admin"` {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a duplicate name error\n", err)
+	}
+}
+
+func TestReadUserRolesQueryCache(t *testing.T) {
+	ctx := context.Background()
+
+	// the roles catalog spans two pages
+	requests := 0
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				requests++
+				response := `{"data":{"userRoles":{"nodes":[{"id":"GLOBAL_ADMIN","name":"Global Admin"}],"pageInfo":{"hasNextPage":true,"endCursor":"1"}}}}`
+				if strings.Contains(string(body), `"after":"1"`) {
+					response = `{"data":{"userRoles":{"nodes":[{"id":"PROJECT_ADMIN","name":"Project Admin","isProjectScoped":true}],"pageInfo":{"hasNextPage":false}}}}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(response)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+		QueryCache: config.NewQueryCache(config.QueryCacheTTL),
+	}
+
+	expected := []*wiz.UserRole{
+		{ID: "GLOBAL_ADMIN", Name: "Global Admin"},
+		{ID: "PROJECT_ADMIN", Name: "Project Admin", IsProjectScoped: true},
+	}
+
+	// simulate the roles lookups of several wiz_user and wiz_saml_idp plans
+	for i := 0; i < 3; i++ {
+		roles, diags := readUserRoles(ctx, m)
+		if diags.HasError() {
+			t.Fatalf("Got:\n\n%#v\n\nExpected no errors\n", diags)
+		}
+		if !reflect.DeepEqual(roles, expected) {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				roles,
+				expected,
+			)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			requests,
+			2,
+		)
+	}
+
+	// without the cache every lookup reads all pages
+	m.QueryCache = nil
+	requests = 0
+	for i := 0; i < 2; i++ {
+		_, diags := readUserRoles(ctx, m)
+		if diags.HasError() {
+			t.Fatalf("Got:\n\n%#v\n\nExpected no errors\n", diags)
+		}
+	}
+	if requests != 4 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			requests,
+			4,
+		)
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizSAMLGroupEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the effective permissions of a SAML provider group, aggregated across all of its group mappings. Useful for access reviews.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"saml_idp_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The SAML identity provider identifier.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"provider_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The provider group identifier.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Roles mapped to the group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Union of the permission scopes of all roles mapped to the group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Union of the project IDs the group mappings are restricted to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"global": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one mapping applies to all projects (i.e. is not restricted to `projects`).",
+			},
+		},
+		ReadContext: dataSourceWizSAMLGroupEffectivePermissionsRead,
+	}
+}
+
+func dataSourceWizSAMLGroupEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizSAMLGroupEffectivePermissionsRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("saml_idp_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("provider_group_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query samlIdentityProvider ($id: ID!){
+	    samlIdentityProvider (
+	        id: $id
+	    ) {
+	        id
+	        groupMapping {
+	            providerGroupId
+	            role {
+	                id
+	                isProjectScoped
+	                scopes
+	            }
+	            projects {
+	                id
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Get("saml_idp_id").(string)
+
+	// process the request
+	data := &ReadSAMLIdentityProviderPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_group_effective_permissions", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	roles, scopes, projects, global := aggregateSAMLGroupPermissions(ctx, d.Get("provider_group_id").(string), data.SAMLIdentityProvider.GroupMapping)
+
+	err := d.Set("roles", roles)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("scopes", scopes)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("projects", projects)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("global", global)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// aggregateSAMLGroupPermissions computes the roles, the union of role scopes and the union of projects for the mappings of a provider group
+// a mapping without projects grants its role on all projects
+func aggregateSAMLGroupPermissions(ctx context.Context, providerGroupID string, groupMappings []*wiz.SAMLGroupMapping) (roles []string, scopes []string, projects []string, global bool) {
+	tflog.Info(ctx, "aggregateSAMLGroupPermissions called...")
+
+	roles = make([]string, 0)
+	scopes = make([]string, 0)
+	projects = make([]string, 0)
+	for _, b := range groupMappings {
+		if b.ProviderGroupID != providerGroupID {
+			continue
+		}
+		tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+		roles = append(roles, b.Role.ID)
+		scopes = append(scopes, b.Role.Scopes...)
+		if len(b.Projects) == 0 {
+			global = true
+		}
+		for _, p := range b.Projects {
+			projects = append(projects, p.ID)
+		}
+	}
+
+	roles = utils.Unique(roles)
+	scopes = utils.Unique(scopes)
+	projects = utils.Unique(projects)
+	sort.Strings(roles)
+	sort.Strings(scopes)
+	sort.Strings(projects)
+	return roles, scopes, projects, global
+}
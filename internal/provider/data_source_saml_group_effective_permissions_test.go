@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestAggregateSAMLGroupPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	var groupMappings = []*wiz.SAMLGroupMapping{
+		{
+			ProviderGroupID: "engineering",
+			Role: wiz.UserRole{
+				ID:     "PROJECT_READER",
+				Scopes: []string{"read:all"},
+			},
+			Projects: []wiz.Project{
+				{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+				{ID: "e7f6542c-81f6-43cf-af48-bdd77f09650d"},
+			},
+		},
+		{
+			ProviderGroupID: "engineering",
+			Role: wiz.UserRole{
+				ID:     "PROJECT_ADMIN",
+				Scopes: []string{"read:all", "write:all"},
+			},
+			Projects: []wiz.Project{
+				{ID: "e7f6542c-81f6-43cf-af48-bdd77f09650d"},
+			},
+		},
+		{
+			ProviderGroupID: "security",
+			Role: wiz.UserRole{
+				ID:     "GLOBAL_ADMIN",
+				Scopes: []string{"admin:all"},
+			},
+		},
+	}
+
+	roles, scopes, projects, global := aggregateSAMLGroupPermissions(ctx, "engineering", groupMappings)
+
+	expectedRoles := []string{"PROJECT_ADMIN", "PROJECT_READER"}
+	expectedScopes := []string{"read:all", "write:all"}
+	expectedProjects := []string{"e7f6542c-81f6-43cf-af48-bdd77f09650d", "ee25cc95-82b0-4543-8934-5bc655b86786"}
+
+	if !reflect.DeepEqual(roles, expectedRoles) || !reflect.DeepEqual(scopes, expectedScopes) || !reflect.DeepEqual(projects, expectedProjects) || global {
+		t.Fatalf(
+			"Got:\n\n%#v %#v %#v %t\n\nExpected:\n\n%#v %#v %#v %t\n",
+			roles, scopes, projects, global,
+			expectedRoles, expectedScopes, expectedProjects, false,
+		)
+	}
+
+	// a mapping without projects applies to all projects
+	_, _, projects, global = aggregateSAMLGroupPermissions(ctx, "security", groupMappings)
+	if len(projects) != 0 || !global {
+		t.Fatalf("Got projects %#v global %t, expected no projects and global", projects, global)
+	}
+}
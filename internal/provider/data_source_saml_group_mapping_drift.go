@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizSAMLGroupMappingDrift() *schema.Resource {
+	return &schema.Resource{
+		Description: "Compare expected SAML group mappings with the group mappings of an identity provider in Wiz and report the drift status of each expected mapping. Useful for drift dashboards that should not run a full plan.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the search.  This is a sha1 hash of the search parameters. Changing the search parameters on this data source will result in a new data source state entry",
+			},
+			"saml_idp_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The SAML identity provider identifier.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"expected": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The expected group mappings, e.g. the `group_mappings` of `wiz_saml_group_mapping_manifest`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_group_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Provider group ID",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Wiz Role name",
+						},
+						"projects": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Project IDs the mapping is restricted to. Leave empty for mappings that apply to all projects.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"drift": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The drift status of each expected mapping, in the order of `expected`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Provider group ID",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expected Wiz Role name.",
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: fmt.Sprintf(
+								"`in_sync` when a mapping of the group has the expected role and projects, `projects_changed` when the mappings of the group with the expected role have other projects, `role_changed` when the group is only mapped to other roles, and `missing` when the group is not mapped.\n    - Allowed values: %s",
+								utils.SliceOfStringToMDUList(
+									wiz.SAMLGroupMappingDriftStatus,
+								),
+							),
+						},
+						"live_roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The roles the group is mapped to in Wiz.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"live_projects": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The projects of the mappings of the group with the expected role in Wiz.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"in_sync": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every expected mapping is `in_sync`.",
+			},
+		},
+		ReadContext: dataSourceWizSAMLGroupMappingDriftRead,
+	}
+}
+
+func dataSourceWizSAMLGroupMappingDriftRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizSAMLGroupMappingDriftRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("saml_idp_id")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("expected")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query samlIdentityProvider ($id: ID!){
+	    samlIdentityProvider (
+	        id: $id
+	    ) {
+	        id
+	        groupMapping {
+	            providerGroupId
+	            role {
+	                id
+	            }
+	            projects {
+	                id
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Get("saml_idp_id").(string)
+
+	// process the request
+	data := &ReadSAMLIdentityProviderPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_group_mapping_drift", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	drift, inSync := samlGroupMappingDrift(ctx, d.Get("expected").([]interface{}), data.SAMLIdentityProvider.GroupMapping)
+
+	err := d.Set("drift", drift)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("in_sync", inSync)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// samlGroupMappingDrift compares each expected mapping with the live mappings of its provider group
+// projects are compared as sets, a mapping without projects applies to all projects
+func samlGroupMappingDrift(ctx context.Context, expected []interface{}, groupMappings []*wiz.SAMLGroupMapping) (drift []interface{}, inSync bool) {
+	tflog.Info(ctx, "samlGroupMappingDrift called...")
+
+	drift = make([]interface{}, 0, len(expected))
+	inSync = true
+	for _, a := range expected {
+		mapping := a.(map[string]interface{})
+		providerGroupID := mapping["provider_group_id"].(string)
+		role := mapping["role"].(string)
+		var projects []string
+		if set, ok := mapping["projects"].(*schema.Set); ok {
+			projects = utils.ConvertListToString(set.List())
+		}
+		projects = utils.Unique(projects)
+		sort.Strings(projects)
+
+		var liveRoles, liveProjects []string
+		status := "missing"
+		for _, b := range groupMappings {
+			if b.ProviderGroupID != providerGroupID {
+				continue
+			}
+			liveRoles = append(liveRoles, b.Role.ID)
+			if b.Role.ID != role {
+				continue
+			}
+			var mappingProjects []string
+			for _, p := range b.Projects {
+				mappingProjects = append(mappingProjects, p.ID)
+			}
+			mappingProjects = utils.Unique(mappingProjects)
+			sort.Strings(mappingProjects)
+			liveProjects = append(liveProjects, mappingProjects...)
+			if slices.Equal(mappingProjects, projects) {
+				status = "in_sync"
+			} else if status != "in_sync" {
+				status = "projects_changed"
+			}
+		}
+		if status == "missing" && len(liveRoles) > 0 {
+			status = "role_changed"
+		}
+		if status != "in_sync" {
+			inSync = false
+		}
+
+		liveRoles = utils.Unique(liveRoles)
+		liveProjects = utils.Unique(liveProjects)
+		sort.Strings(liveRoles)
+		sort.Strings(liveProjects)
+		drift = append(drift, map[string]interface{}{
+			"provider_group_id": providerGroupID,
+			"role":              role,
+			"status":            status,
+			"live_roles":        liveRoles,
+			"live_projects":     liveProjects,
+		})
+	}
+	return drift, inSync
+}
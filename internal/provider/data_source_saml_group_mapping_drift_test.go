@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestSAMLGroupMappingDrift(t *testing.T) {
+	ctx := context.Background()
+
+	var groupMappings = []*wiz.SAMLGroupMapping{
+		{
+			ProviderGroupID: "engineering",
+			Role:            wiz.UserRole{ID: "PROJECT_READER"},
+			Projects: []wiz.Project{
+				{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+				{ID: "e7f6542c-81f6-43cf-af48-bdd77f09650d"},
+			},
+		},
+		{
+			ProviderGroupID: "operations",
+			Role:            wiz.UserRole{ID: "PROJECT_MEMBER"},
+			Projects: []wiz.Project{
+				{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+			},
+		},
+		{
+			ProviderGroupID: "security",
+			Role:            wiz.UserRole{ID: "GLOBAL_ADMIN"},
+		},
+	}
+
+	var expected = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "operations",
+			"role":              "PROJECT_MEMBER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "security",
+			"role":              "GLOBAL_READER",
+			"projects":          schema.NewSet(schema.HashString, []interface{}{}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "auditors",
+			"role":              "GLOBAL_READER",
+			"projects":          schema.NewSet(schema.HashString, []interface{}{}),
+		},
+	}
+
+	drift, inSync := samlGroupMappingDrift(ctx, expected, groupMappings)
+
+	var expectedDrift = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"status":            "in_sync",
+			"live_roles":        []string{"PROJECT_READER"},
+			"live_projects":     []string{"e7f6542c-81f6-43cf-af48-bdd77f09650d", "ee25cc95-82b0-4543-8934-5bc655b86786"},
+		},
+		map[string]interface{}{
+			"provider_group_id": "operations",
+			"role":              "PROJECT_MEMBER",
+			"status":            "projects_changed",
+			"live_roles":        []string{"PROJECT_MEMBER"},
+			"live_projects":     []string{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+		},
+		map[string]interface{}{
+			"provider_group_id": "security",
+			"role":              "GLOBAL_READER",
+			"status":            "role_changed",
+			"live_roles":        []string{"GLOBAL_ADMIN"},
+			"live_projects":     []string(nil),
+		},
+		map[string]interface{}{
+			"provider_group_id": "auditors",
+			"role":              "GLOBAL_READER",
+			"status":            "missing",
+			"live_roles":        []string(nil),
+			"live_projects":     []string(nil),
+		},
+	}
+
+	if !reflect.DeepEqual(drift, expectedDrift) || inSync {
+		t.Fatalf(
+			"Got:\n\n%#v %t\n\nExpected:\n\n%#v %t\n",
+			drift, inSync,
+			expectedDrift, false,
+		)
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizSAMLGroupMappingManifest() *schema.Resource {
+	return &schema.Resource{
+		Description: "Read SAML group mappings from a local JSON or CSV manifest file. The returned mappings are validated and normalized, and can drive the `group_mapping` blocks of `wiz_saml_idp` with a `dynamic` block. No Wiz API calls are made.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the manifest.  This is a sha1 hash of the path, format and file contents.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the manifest file. A JSON manifest is an array of objects with `provider_group_id`, `role` and `projects` (a list) keys. A CSV manifest has a header row with `provider_group_id`, `role` and `projects` columns, with the projects of a row separated by `;`.",
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The manifest format. Defaults to the format matching the file extension.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.SAMLGroupMappingManifestFormat,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.SAMLGroupMappingManifestFormat,
+						false,
+					),
+				),
+			},
+			"group_mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The group mappings, sorted by provider group and role.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A unique key for the mapping, `<provider_group_id>/<role>`, for use with `for_each`.",
+						},
+						"provider_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Provider group ID",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Wiz Role name",
+						},
+						"projects": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The mapped projects, sorted and without duplicates. Empty for mappings that apply to all projects.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceWizSAMLGroupMappingManifestRead,
+	}
+}
+
+// samlGroupMappingManifestRow struct -- a group mapping read from a manifest, with the line it was defined on
+type samlGroupMappingManifestRow struct {
+	ProviderGroupID string   `json:"provider_group_id"`
+	Role            string   `json:"role"`
+	Projects        []string `json:"projects"`
+	Line            int      `json:"-"`
+}
+
+func dataSourceWizSAMLGroupMappingManifestRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizSAMLGroupMappingManifestRead called...")
+
+	path := d.Get("path").(string)
+	format := d.Get("format").(string)
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the path, format and contents
+	var identifier bytes.Buffer
+	identifier.WriteString(path)
+	identifier.WriteString(format)
+	identifier.Write(content)
+	h := sha1.New()
+	h.Write(identifier.Bytes())
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	// parse and validate the rows
+	var rows []*samlGroupMappingManifestRow
+	switch format {
+	case "json":
+		rows, err = parseSAMLGroupMappingManifestJSON(content)
+	case "csv":
+		rows, err = parseSAMLGroupMappingManifestCSV(content)
+	default:
+		err = fmt.Errorf("unable to determine the manifest format from %s, set format to one of: %s", path, strings.Join(wiz.SAMLGroupMappingManifestFormat, ", "))
+	}
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid group mapping manifest %s", path),
+			Detail:   err.Error(),
+		})
+	}
+	for _, e := range validateSAMLGroupMappingManifestRows(rows) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid group mapping manifest %s", path),
+			Detail:   e,
+		})
+	}
+	if len(diags) > 0 {
+		return diags
+	}
+
+	err = d.Set("group_mappings", flattenSAMLGroupMappingManifestRows(ctx, rows))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// parseSAMLGroupMappingManifestJSON reads the rows of a JSON manifest, recording the line each row starts on
+func parseSAMLGroupMappingManifestJSON(content []byte) ([]*samlGroupMappingManifestRow, error) {
+	lineAt := func(offset int64) int {
+		return bytes.Count(content[:offset], []byte("\n")) + 1
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", lineAt(decoder.InputOffset()), err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("line %d: expected an array of group mappings", lineAt(decoder.InputOffset()))
+	}
+
+	var rows []*samlGroupMappingManifestRow
+	for decoder.More() {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineAt(decoder.InputOffset()), err)
+		}
+		line := lineAt(decoder.InputOffset() - int64(len(raw)))
+
+		row := &samlGroupMappingManifestRow{Line: line}
+		rowDecoder := json.NewDecoder(bytes.NewReader(raw))
+		rowDecoder.DisallowUnknownFields()
+		err = rowDecoder.Decode(row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseSAMLGroupMappingManifestCSV reads the rows of a CSV manifest, recording the line each row is on
+func parseSAMLGroupMappingManifestCSV(content []byte) ([]*samlGroupMappingManifestRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	// map the columns from the header
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("line 1: unable to read the header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"provider_group_id", "role", "projects"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("line 1: missing the %s column", name)
+		}
+	}
+
+	var rows []*samlGroupMappingManifestRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		row := &samlGroupMappingManifestRow{
+			ProviderGroupID: strings.TrimSpace(record[columns["provider_group_id"]]),
+			Role:            strings.TrimSpace(record[columns["role"]]),
+			Line:            line,
+		}
+		for _, p := range strings.Split(record[columns["projects"]], ";") {
+			if strings.TrimSpace(p) != "" {
+				row.Projects = append(row.Projects, strings.TrimSpace(p))
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// validateSAMLGroupMappingManifestRows returns an error for each invalid or duplicated row, naming its line
+func validateSAMLGroupMappingManifestRows(rows []*samlGroupMappingManifestRow) []string {
+	var errs []string
+	seen := make(map[string]int)
+	for _, row := range rows {
+		if row.ProviderGroupID == "" {
+			errs = append(errs, fmt.Sprintf("line %d: provider_group_id is required", row.Line))
+		}
+		if row.Role == "" {
+			errs = append(errs, fmt.Sprintf("line %d: role is required", row.Line))
+		}
+		for _, p := range row.Projects {
+			if strings.TrimSpace(p) == "" {
+				errs = append(errs, fmt.Sprintf("line %d: projects must not contain empty values", row.Line))
+				break
+			}
+		}
+		if row.ProviderGroupID == "" || row.Role == "" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", row.ProviderGroupID, row.Role)
+		if first, ok := seen[key]; ok {
+			errs = append(errs, fmt.Sprintf("line %d: duplicate mapping for provider group %s and role %s, first defined on line %d", row.Line, row.ProviderGroupID, row.Role, first))
+			continue
+		}
+		seen[key] = row.Line
+	}
+	return errs
+}
+
+func flattenSAMLGroupMappingManifestRows(ctx context.Context, rows []*samlGroupMappingManifestRow) []interface{} {
+	tflog.Info(ctx, "flattenSAMLGroupMappingManifestRows called...")
+
+	var output = make([]interface{}, 0, len(rows))
+	for _, b := range rows {
+		tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+		projects := utils.Unique(b.Projects)
+		sort.Strings(projects)
+		var mapping = make(map[string]interface{})
+		mapping["key"] = fmt.Sprintf("%s/%s", b.ProviderGroupID, b.Role)
+		mapping["provider_group_id"] = b.ProviderGroupID
+		mapping["role"] = b.Role
+		mapping["projects"] = utils.ConvertSliceToGenericArray(projects)
+		output = append(output, mapping)
+	}
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].(map[string]interface{})["key"].(string) < output[j].(map[string]interface{})["key"].(string)
+	})
+	return output
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseSAMLGroupMappingManifestJSON(t *testing.T) {
+	content := []byte(`[
+  {
+    "provider_group_id": "global.admin",
+    "role": "GLOBAL_ADMIN"
+  },
+  {"provider_group_id": "team-a", "role": "PROJECT_ADMIN", "projects": ["slug:team-a", "ee25cc95-82b0-4543-8934-5bc655b86786"]}
+]`)
+
+	expected := []*samlGroupMappingManifestRow{
+		{
+			ProviderGroupID: "global.admin",
+			Role:            "GLOBAL_ADMIN",
+			Line:            2,
+		},
+		{
+			ProviderGroupID: "team-a",
+			Role:            "PROJECT_ADMIN",
+			Projects: []string{
+				"slug:team-a",
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			},
+			Line: 6,
+		},
+	}
+
+	rows, err := parseSAMLGroupMappingManifestJSON(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, rows) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			rows,
+			expected,
+		)
+	}
+
+	_, err = parseSAMLGroupMappingManifestJSON([]byte("[\n  {\"provider_group_id\": \"team-a\"},\n  {\"group\": \"team-b\"}\n]"))
+	if err == nil || err.Error() != `line 3: json: unknown field "group"` {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", err, `line 3: json: unknown field "group"`)
+	}
+}
+
+func TestParseSAMLGroupMappingManifestCSV(t *testing.T) {
+	content := []byte(`provider_group_id,role,projects
+global.admin,GLOBAL_ADMIN,
+team-a,PROJECT_ADMIN,slug:team-a; ee25cc95-82b0-4543-8934-5bc655b86786
+`)
+
+	expected := []*samlGroupMappingManifestRow{
+		{
+			ProviderGroupID: "global.admin",
+			Role:            "GLOBAL_ADMIN",
+			Line:            2,
+		},
+		{
+			ProviderGroupID: "team-a",
+			Role:            "PROJECT_ADMIN",
+			Projects: []string{
+				"slug:team-a",
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			},
+			Line: 3,
+		},
+	}
+
+	rows, err := parseSAMLGroupMappingManifestCSV(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, rows) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			rows,
+			expected,
+		)
+	}
+}
+
+func TestValidateSAMLGroupMappingManifestRows(t *testing.T) {
+	rows := []*samlGroupMappingManifestRow{
+		{ProviderGroupID: "team-a", Role: "PROJECT_ADMIN", Line: 2},
+		{ProviderGroupID: "team-b", Line: 3},
+		{ProviderGroupID: "team-a", Role: "PROJECT_ADMIN", Line: 4},
+		{ProviderGroupID: "team-c", Role: "PROJECT_READER", Projects: []string{""}, Line: 5},
+	}
+
+	expected := []string{
+		"line 3: role is required",
+		"line 4: duplicate mapping for provider group team-a and role PROJECT_ADMIN, first defined on line 2",
+		"line 5: projects must not contain empty values",
+	}
+
+	errs := validateSAMLGroupMappingManifestRows(rows)
+	if !reflect.DeepEqual(expected, errs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			errs,
+			expected,
+		)
+	}
+}
+
+func TestFlattenSAMLGroupMappingManifestRows(t *testing.T) {
+	ctx := context.Background()
+	rows := []*samlGroupMappingManifestRow{
+		{ProviderGroupID: "team-b", Role: "PROJECT_READER", Projects: []string{"slug:b", "slug:a", "slug:b"}},
+		{ProviderGroupID: "team-a", Role: "PROJECT_ADMIN"},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"key":               "team-a/PROJECT_ADMIN",
+			"provider_group_id": "team-a",
+			"role":              "PROJECT_ADMIN",
+			"projects":          []interface{}{},
+		},
+		map[string]interface{}{
+			"key":               "team-b/PROJECT_READER",
+			"provider_group_id": "team-b",
+			"role":              "PROJECT_READER",
+			"projects":          []interface{}{"slug:a", "slug:b"},
+		},
+	}
+
+	mappings := flattenSAMLGroupMappingManifestRows(ctx, rows)
+	if !reflect.DeepEqual(expected, mappings) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			mappings,
+			expected,
+		)
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// resourceNameInvalidChars matches the characters that are not allowed in a terraform resource name
+var resourceNameInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func dataSourceWizSAMLIdPConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Generate the Terraform configuration of an existing SAML identity provider, including its group mappings, and the command to import it. Use this to bring identity providers configured in the Wiz portal under Terraform management.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"saml_idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The identifier of the SAML identity provider.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the generated `wiz_saml_idp` resource. Defaults to the identity provider name, lower cased, with characters that are not allowed in resource names replaced by `_`.",
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `wiz_saml_idp` resource block, followed by an `import` block for Terraform 1.5 and later.",
+			},
+			"import_command": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `terraform import` command for the generated resource.",
+			},
+		},
+		ReadContext: dataSourceWizSAMLIdPConfigRead,
+	}
+}
+
+func dataSourceWizSAMLIdPConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizSAMLIdPConfigRead called...")
+
+	// define the graphql query
+	query := `query samlIdentityProvider ($id: ID!){
+	    samlIdentityProvider (
+	        id: $id
+	    ) {
+	        id
+	        name
+	        issuerURL
+	        loginURL
+	        logoutURL
+	        useProviderManagedRoles
+	        allowManualRoleOverride
+	        certificate
+	        mergeGroupsMappingByRole
+	        groupMapping {
+	            providerGroupId
+	            role {
+	                id
+	            }
+	            projects {
+	                id
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Get("saml_idp_id").(string)
+
+	// process the request
+	data := &ReadSAMLIdentityProviderPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_idp", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	if data.SAMLIdentityProvider.ID == "" {
+		return append(diags, diag.Errorf("SAML identity provider %s not found", vars.ID)...)
+	}
+
+	// set the id
+	d.SetId(data.SAMLIdentityProvider.ID)
+
+	resourceName := d.Get("resource_name").(string)
+	if resourceName == "" {
+		resourceName = samlIdPResourceName(data.SAMLIdentityProvider.Name)
+	}
+	err := d.Set("resource_name", resourceName)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("hcl", generateSAMLIdPConfig(&data.SAMLIdentityProvider, resourceName))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("import_command", fmt.Sprintf("terraform import wiz_saml_idp.%s %q", resourceName, data.SAMLIdentityProvider.ID))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// samlIdPResourceName derives a valid terraform resource name from an identity provider name
+func samlIdPResourceName(name string) string {
+	resourceName := strings.Trim(resourceNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if resourceName == "" {
+		return "saml_idp"
+	}
+	if resourceName[0] >= '0' && resourceName[0] <= '9' || resourceName[0] == '-' {
+		return "idp_" + resourceName
+	}
+	return resourceName
+}
+
+// generateSAMLIdPConfig returns the wiz_saml_idp resource block and import block for an identity provider
+// optional arguments are only written when they are set, group mappings keep the order returned by Wiz
+func generateSAMLIdPConfig(idp *wiz.SAMLIdentityProvider, resourceName string) string {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	resource := body.AppendNewBlock("resource", []string{"wiz_saml_idp", resourceName}).Body()
+	resource.SetAttributeValue("name", cty.StringVal(idp.Name))
+	resource.SetAttributeValue("login_url", cty.StringVal(idp.LoginURL))
+	if idp.IssuerURL != "" {
+		resource.SetAttributeValue("issuer_url", cty.StringVal(idp.IssuerURL))
+	}
+	if idp.LogoutURL != "" {
+		resource.SetAttributeValue("logout_url", cty.StringVal(idp.LogoutURL))
+	}
+	resource.SetAttributeValue("certificate", cty.StringVal(idp.Certificate))
+	resource.SetAttributeValue("use_provider_managed_roles", cty.BoolVal(idp.UseProviderManagedRoles))
+	if idp.AllowManualRoleOverride != nil {
+		resource.SetAttributeValue("allow_manual_role_override", cty.BoolVal(*idp.AllowManualRoleOverride))
+	}
+	resource.SetAttributeValue("merge_groups_mapping_by_role", cty.BoolVal(idp.MergeGroupsMappingByRole))
+
+	for _, a := range idp.GroupMapping {
+		resource.AppendNewline()
+		mapping := resource.AppendNewBlock("group_mapping", nil).Body()
+		mapping.SetAttributeValue("provider_group_id", cty.StringVal(a.ProviderGroupID))
+		mapping.SetAttributeValue("role", cty.StringVal(a.Role.ID))
+		if len(a.Projects) > 0 {
+			projects := make([]cty.Value, 0, len(a.Projects))
+			for _, b := range a.Projects {
+				projects = append(projects, cty.StringVal(b.ID))
+			}
+			mapping.SetAttributeValue("projects", cty.ListVal(projects))
+		}
+	}
+
+	body.AppendNewline()
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: "wiz_saml_idp"},
+		hcl.TraverseAttr{Name: resourceName},
+	})
+	importBlock.SetAttributeValue("id", cty.StringVal(idp.ID))
+
+	return string(f.Bytes())
+}
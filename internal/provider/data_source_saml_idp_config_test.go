@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestSAMLIdPResourceName(t *testing.T) {
+	var tests = map[string]string{
+		"Okta":             "okta",
+		"Azure AD (corp)":  "azure_ad_corp",
+		"1Login":           "idp_1login",
+		"---":              "idp_---",
+		"":                 "saml_idp",
+		"Ping_Federate-v2": "ping_federate-v2",
+	}
+
+	for name, expected := range tests {
+		resourceName := samlIdPResourceName(name)
+		if resourceName != expected {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				resourceName,
+				expected,
+			)
+		}
+	}
+}
+
+func TestGenerateSAMLIdPConfig(t *testing.T) {
+	idp := &wiz.SAMLIdentityProvider{
+		ID:                      "okta-id",
+		Name:                    "Okta ${env}",
+		LoginURL:                "https://example.okta.com/app/wiz/sso/saml",
+		Certificate:             "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+		AllowManualRoleOverride: utils.ConvertBoolToPointer(true),
+		GroupMapping: []*wiz.SAMLGroupMapping{
+			{
+				ProviderGroupID: "platform",
+				Role:            wiz.UserRole{ID: "PROJECT_ADMIN"},
+				Projects: []wiz.Project{
+					{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+				},
+			},
+			{
+				ProviderGroupID: "security",
+				Role:            wiz.UserRole{ID: "GLOBAL_READER"},
+			},
+		},
+	}
+
+	expected := `resource "wiz_saml_idp" "okta" {
+  name                         = "Okta $${env}"
+  login_url                    = "https://example.okta.com/app/wiz/sso/saml"
+  certificate                  = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+  use_provider_managed_roles   = false
+  allow_manual_role_override   = true
+  merge_groups_mapping_by_role = false
+
+  group_mapping {
+    provider_group_id = "platform"
+    role              = "PROJECT_ADMIN"
+    projects          = ["ee25cc95-82b0-4543-8934-5bc655b86786"]
+  }
+
+  group_mapping {
+    provider_group_id = "security"
+    role              = "GLOBAL_READER"
+  }
+}
+
+import {
+  to = wiz_saml_idp.okta
+  id = "okta-id"
+}
+`
+
+	config := generateSAMLIdPConfig(idp, "okta")
+	if config != expected {
+		t.Fatalf(
+			"Got:\n\n%s\n\nExpected:\n\n%s\n",
+			config,
+			expected,
+		)
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizSavedQuery() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a saved security graph query by name. Use this to embed an existing saved query in controls and reports instead of duplicating its definition.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Wiz internal identifier for the saved query.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The exact name of the saved query.",
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The security graph query, as normalized JSON (compact, with sorted keys) so the value is stable between reads.",
+			},
+		},
+		ReadContext: dataSourceWizSavedQueryRead,
+	}
+}
+
+// ReadSavedGraphQueries struct
+type ReadSavedGraphQueries struct {
+	SavedGraphQueries wiz.SavedGraphQueryConnection `json:"savedGraphQueries"`
+}
+
+func dataSourceWizSavedQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizSavedQueryRead called...")
+
+	// define the graphql query
+	query := `query savedGraphQueries (
+	    $first: Int
+	    $after: String
+	    $filterBy: SavedGraphQueryFilters
+	){
+	    savedGraphQueries(
+	        first: $first
+	        after: $after
+	        filterBy: $filterBy
+	    ) {
+	        nodes {
+	            id
+	            name
+	            query
+	        }
+	        pageInfo {
+	            endCursor
+	            hasNextPage
+	        }
+	        totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = 500
+	vars.FilterBy = &wiz.SavedGraphQueryFilters{
+		Search: d.Get("name").(string),
+	}
+
+	// process the request
+	data := &ReadSavedGraphQueries{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "saved_queries", "read", 0)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	savedQuery, findDiags := findSavedQuery(ctx, d.Get("name").(string), allData)
+	diags = append(diags, findDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	normalized, err := utils.NormalizeJSON(string(savedQuery.Query))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// set the id and resource parameters
+	d.SetId(savedQuery.ID)
+	err = d.Set("query", normalized)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// findSavedQuery returns the single saved query whose name matches exactly
+// the search filter is a partial match, so the results are matched again client side
+func findSavedQuery(ctx context.Context, name string, savedQueries []interface{}) (*wiz.SavedGraphQuery, diag.Diagnostics) {
+	tflog.Info(ctx, "findSavedQuery called...")
+
+	var matches []*wiz.SavedGraphQuery
+	for _, a := range savedQueries {
+		for _, b := range a.(*ReadSavedGraphQueries).SavedGraphQueries.Nodes {
+			tflog.Trace(ctx, fmt.Sprintf("b: %T %s", b, utils.PrettyPrint(b)))
+			if b.Name == name {
+				matches = append(matches, b)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Saved query not found",
+			Detail:   fmt.Sprintf("No saved query was found with name %q.", name),
+		}}
+	default:
+		var ids []string
+		for _, q := range matches {
+			ids = append(ids, q.ID)
+		}
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Ambiguous saved query name",
+			Detail:   fmt.Sprintf("%d saved queries were found with name %q: %s", len(matches), name, strings.Join(ids, ", ")),
+		}}
+	}
+}
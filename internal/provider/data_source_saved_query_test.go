@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFindSavedQuery(t *testing.T) {
+	ctx := context.Background()
+
+	expected := &wiz.SavedGraphQuery{
+		ID:    "8e1f0c2a-6b3d-4e5f-9a7b-1c2d3e4f5a6b",
+		Name:  "Public VMs",
+		Query: []byte(`{"type":["VIRTUAL_MACHINE"]}`),
+	}
+
+	var savedQueries = []interface{}{
+		&ReadSavedGraphQueries{
+			SavedGraphQueries: wiz.SavedGraphQueryConnection{
+				Nodes: []*wiz.SavedGraphQuery{
+					{
+						ID:   "2b4c6d8e-0f1a-4b3c-8d5e-7f9a0b1c2d3e",
+						Name: "Public VMs (legacy)",
+					},
+					expected,
+				},
+			},
+		},
+	}
+
+	savedQuery, diags := findSavedQuery(ctx, "Public VMs", savedQueries)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
+	if !reflect.DeepEqual(savedQuery, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			savedQuery,
+			expected,
+		)
+	}
+
+	// a second page with the same name makes the lookup ambiguous
+	savedQueries = append(savedQueries, &ReadSavedGraphQueries{
+		SavedGraphQueries: wiz.SavedGraphQueryConnection{
+			Nodes: []*wiz.SavedGraphQuery{
+				{
+					ID:   "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+					Name: "Public VMs",
+				},
+			},
+		},
+	})
+	_, diags = findSavedQuery(ctx, "Public VMs", savedQueries)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error for an ambiguous name")
+	}
+
+	_, diags = findSavedQuery(ctx, "Unknown", savedQueries)
+	if !diags.HasError() {
+		t.Fatalf("Expected an error for an unknown name")
+	}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// ReadServiceAccounts struct
+type ReadServiceAccounts struct {
+	ServiceAccounts wiz.ServiceAccountConnection `json:"serviceAccounts"`
+}
+
+func dataSourceWizServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the details for Wiz service accounts.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal identifier for the data.",
+			},
+			"first": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "How many matches to return, maximum is `100` is per page.",
+			},
+			"max_pages": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "How many pages to return. 0 means all pages.",
+			},
+			"has_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return service accounts whose scopes include this scope (e.g. `admin:users`).",
+			},
+			"service_accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The returned service accounts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Wiz internal identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service account name.",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service account client identifier.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service account type.",
+						},
+						"scopes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Permission scopes.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"assigned_projects": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Assigned project IDs.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time.",
+						},
+						"last_rotated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last secret rotation time.",
+						},
+					},
+				},
+			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
+		},
+		ReadContext: dataSourceWizServiceAccountsRead,
+	}
+}
+
+func dataSourceWizServiceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizServiceAccountsRead called...")
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the search parameters
+	var identifier bytes.Buffer
+
+	a, b := d.GetOk("first")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	a, b = d.GetOk("has_scope")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(a))
+	}
+	maxPages, b := d.GetOk("max_pages")
+	if b {
+		identifier.WriteString(utils.PrettyPrint(maxPages))
+	}
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	hashID := hex.EncodeToString(h.Sum(nil))
+
+	// Set the id
+	d.SetId(hashID)
+
+	// define the graphql query
+	query := `query serviceAccounts(
+	  $first: Int
+	  $after: String
+	){
+	  serviceAccounts(
+	    first: $first,
+	    after: $after
+	  ) {
+	      nodes {
+	        id
+	        name
+	        clientId
+	        type
+	        scopes
+	        createdAt
+	        lastRotatedAt
+	        assignedProjects {
+	          id
+	        }
+	      }
+	      pageInfo {
+	        endCursor
+	        hasNextPage
+	      }
+	      totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = d.Get("first").(int)
+
+	// process the request
+	data := &ReadServiceAccounts{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "service_accounts", "read", maxPages.(int))
+	tflog.Debug(ctx, fmt.Sprintf("allData: %s", utils.PrettyPrint(allData)))
+
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// the api does not support filtering by scope, so the filter is applied client side
+	serviceAccounts := flattenServiceAccounts(ctx, allData, d.Get("has_scope").(string))
+	utils.SortFlattenedList(serviceAccounts, d.Get("order_by").(string))
+	if err := d.Set("service_accounts", serviceAccounts); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func flattenServiceAccounts(ctx context.Context, serviceAccounts []interface{}, hasScope string) []interface{} {
+	tflog.Info(ctx, "flattenServiceAccounts called...")
+
+	// walk the slice and construct the list
+	var output = make([]interface{}, 0)
+	for _, a := range serviceAccounts {
+		readServiceAccounts := a.(*ReadServiceAccounts)
+		for _, c := range readServiceAccounts.ServiceAccounts.Nodes {
+			tflog.Trace(ctx, fmt.Sprintf("c: %T %s", c, utils.PrettyPrint(c)))
+			if hasScope != "" && len(utils.Missing(c.Scopes, []string{hasScope})) > 0 {
+				continue
+			}
+			serviceAccountMap := make(map[string]interface{})
+			serviceAccountMap["id"] = c.ID
+			serviceAccountMap["name"] = c.Name
+			serviceAccountMap["client_id"] = c.ClientID
+			serviceAccountMap["type"] = c.Type
+			serviceAccountMap["scopes"] = c.Scopes
+			serviceAccountMap["created_at"] = c.CreatedAt
+			serviceAccountMap["last_rotated_at"] = c.LastRotatedAt
+			projects := make([]interface{}, 0)
+			for _, p := range c.AssignedProjects {
+				projects = append(projects, p.ID)
+			}
+			serviceAccountMap["assigned_projects"] = projects
+			output = append(output, serviceAccountMap)
+		}
+	}
+	return output
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestFlattenServiceAccountsHasScope(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":              "0d1f5b6a-3c2e-4f7a-9b8c-1d2e3f4a5b6c",
+			"name":            "automation",
+			"client_id":       "abcdefghijklmnopqrstuvwxyz",
+			"type":            "THIRD_PARTY",
+			"scopes":          []string{"read:all", "admin:users"},
+			"created_at":      "2023-06-01T00:00:00Z",
+			"last_rotated_at": "2023-06-01T00:00:00Z",
+			"assigned_projects": []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			},
+		},
+	}
+
+	var serviceAccounts = []interface{}{
+		&ReadServiceAccounts{
+			ServiceAccounts: wiz.ServiceAccountConnection{
+				Nodes: []*wiz.ServiceAccount{
+					{
+						ID:            "0d1f5b6a-3c2e-4f7a-9b8c-1d2e3f4a5b6c",
+						Name:          "automation",
+						ClientID:      "abcdefghijklmnopqrstuvwxyz",
+						Type:          "THIRD_PARTY",
+						Scopes:        []string{"read:all", "admin:users"},
+						CreatedAt:     "2023-06-01T00:00:00Z",
+						LastRotatedAt: "2023-06-01T00:00:00Z",
+						AssignedProjects: []*wiz.Project{
+							{ID: "ee25cc95-82b0-4543-8934-5bc655b86786"},
+						},
+					},
+					{
+						ID:     "7e8f9a0b-1c2d-4e3f-8a9b-0c1d2e3f4a5b",
+						Name:   "reader",
+						Type:   "THIRD_PARTY",
+						Scopes: []string{"read:all"},
+					},
+				},
+			},
+		},
+	}
+
+	flattened := flattenServiceAccounts(ctx, serviceAccounts, "admin:users")
+
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			flattened,
+			expected,
+		)
+	}
+
+	if all := flattenServiceAccounts(ctx, serviceAccounts, ""); len(all) != 2 {
+		t.Fatalf("Got %d service accounts without a scope filter, expected 2", len(all))
+	}
+}
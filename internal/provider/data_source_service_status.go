@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// serviceStatusAPIVersionHeader is the response header used to report the api version, when present
+const serviceStatusAPIVersionHeader = "X-Wiz-Api-Version"
+
+func dataSourceWizServiceStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Verify that the provider can reach the Wiz API and authenticate with the configured credentials. Intended as a preflight check; a failure reports whether authentication or connectivity is at fault.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the check.  This is a sha1 hash of the Wiz API URL.",
+			},
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the provider authenticated successfully.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Wiz tenant identifier of the authenticated principal.",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API version reported by the Wiz API. Empty when the API does not report a version.",
+			},
+		},
+		ReadContext: dataSourceWizServiceStatusRead,
+	}
+}
+
+// ReadViewerPayload struct
+type ReadViewerPayload struct {
+	Viewer wiz.Viewer `json:"viewer"`
+}
+
+func dataSourceWizServiceStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizServiceStatusRead called...")
+
+	conf := m.(*config.ProviderConf)
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the api url
+	h := sha1.New()
+	h.Write([]byte(conf.Settings.WizURL))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	// no session token is issued when the client credentials are rejected
+	if conf.Token == "" {
+		return serviceStatusDiagnostic(0, nil, nil)
+	}
+
+	// define the graphql query
+	query := `query serviceStatus {
+	    viewer {
+	        id
+	        tenant {
+	            id
+	        }
+	    }
+	}`
+
+	// process the request
+	// the request is issued directly so transport and authentication failures can be told apart
+	b := new(bytes.Buffer)
+	err := json.NewEncoder(b).Encode(client.GraphQLRequest{Query: query, Variables: struct{}{}})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	request, failed, requestDiags := client.CreateRequest(ctx, m, b, diags, "service_status", "read")
+	if failed {
+		return requestDiags
+	}
+	resp, err := conf.HTTPClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return serviceStatusDiagnostic(0, err, nil)
+	}
+	defer resp.Body.Close()
+
+	rbody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return serviceStatusDiagnostic(0, err, nil)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("service_status read api response (%d): %s", resp.StatusCode, utils.RedactHTTPDump(rbody)))
+	if resp.StatusCode != http.StatusOK {
+		return serviceStatusDiagnostic(resp.StatusCode, nil, nil)
+	}
+
+	data := &ReadViewerPayload{}
+	responseBody := &client.MutationPayload{Data: data}
+	err = json.Unmarshal(rbody, &responseBody)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if len(responseBody.Errors) > 0 {
+		var codes []string
+		for _, e := range responseBody.Errors {
+			codes = append(codes, e.Extensions.Code)
+		}
+		return serviceStatusDiagnostic(resp.StatusCode, nil, codes)
+	}
+
+	// set the data source parameters
+	err = d.Set("authenticated", true)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("tenant_id", data.Viewer.Tenant.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("api_version", resp.Header.Get(serviceStatusAPIVersionHeader))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// serviceStatusDiagnostic classifies a failed service status check as an authentication or connectivity error
+// statusCode is 0 when no http response was received; err is set for transport errors; errorCodes holds graphql error codes
+func serviceStatusDiagnostic(statusCode int, err error, errorCodes []string) diag.Diagnostics {
+	authentication := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Wiz authentication failed",
+		Detail:   "The Wiz API rejected the provider credentials. Verify wiz_auth_client_id, wiz_auth_client_secret, wiz_auth_url and wiz_auth_audience.",
+	}
+
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "Unable to reach the Wiz API",
+				Detail:   fmt.Sprintf("The request to the Wiz API failed before a response was received. Verify wiz_url, proxy settings and network connectivity.\n\nError: %s", err),
+			},
+		}
+	}
+
+	switch statusCode {
+	case 0:
+		authentication.Detail = "No session token was issued for the configured client credentials. " + authentication.Detail
+		return diag.Diagnostics{authentication}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		authentication.Detail = fmt.Sprintf("HTTP Response (%d). %s", statusCode, authentication.Detail)
+		return diag.Diagnostics{authentication}
+	case http.StatusOK:
+	default:
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "Wiz API returned an unexpected response",
+				Detail:   fmt.Sprintf("HTTP Response (%d). The Wiz API was reached but did not complete the request.", statusCode),
+			},
+		}
+	}
+
+	for _, code := range errorCodes {
+		if code == "UNAUTHENTICATED" || code == "FORBIDDEN" {
+			authentication.Detail = fmt.Sprintf("GraphQL error code %s. %s", code, authentication.Detail)
+			return diag.Diagnostics{authentication}
+		}
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "Wiz API reported errors",
+			Detail:   fmt.Sprintf("The Wiz API was reached but the status query failed. Error codes: %s", utils.PrettyPrint(errorCodes)),
+		},
+	}
+}
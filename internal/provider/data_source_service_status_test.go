@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestServiceStatusDiagnostic(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		err        error
+		errorCodes []string
+		expected   string
+	}{
+		{
+			name:     "transport error",
+			err:      errors.New("dial tcp: lookup api.example.com: no such host"),
+			expected: "Unable to reach the Wiz API",
+		},
+		{
+			name:     "no session token",
+			expected: "Wiz authentication failed",
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			expected:   "Wiz authentication failed",
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusBadGateway,
+			expected:   "Wiz API returned an unexpected response",
+		},
+		{
+			name:       "unauthenticated graphql error",
+			statusCode: http.StatusOK,
+			errorCodes: []string{"UNAUTHENTICATED"},
+			expected:   "Wiz authentication failed",
+		},
+		{
+			name:       "other graphql error",
+			statusCode: http.StatusOK,
+			errorCodes: []string{"INTERNAL"},
+			expected:   "Wiz API reported errors",
+		},
+	}
+
+	for _, c := range cases {
+		diags := serviceStatusDiagnostic(c.statusCode, c.err, c.errorCodes)
+		if len(diags) != 1 || diags[0].Summary != c.expected {
+			t.Fatalf("%s: Got:\n\n%#v\n\nExpected summary:\n\n%s\n", c.name, diags, c.expected)
+		}
+	}
+}
@@ -78,6 +78,23 @@ func dataSourceWizSubscriptionResourceGroups() *schema.Resource {
 					},
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizSubscriptionResourceGroupsRead,
 	}
@@ -191,6 +208,7 @@ func dataSourceWizSubscriptionResourceGroupsRead(ctx context.Context, d *schema.
 	}
 
 	resourceGroups := flattenResourceGroups(ctx, &data.SubscriptionResourceGroups.Nodes)
+	utils.SortFlattenedList(resourceGroups, d.Get("order_by").(string))
 
 	if err := d.Set("resource_groups", resourceGroups); err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -150,6 +150,23 @@ func dataSourceWizUsers() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "name",
+				Description: fmt.Sprintf(
+					"Order of the returned list, so that it is stable between reads (e.g. for `for_each` keys). `name` sorts by name, then ID.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ListOrderBy,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ListOrderBy,
+						false,
+					),
+				),
+			},
 		},
 		ReadContext: dataSourceWizUsersRead,
 	}
@@ -274,6 +291,7 @@ func dataSourceWizUsersRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	users := flattenUsers(ctx, allData)
+	utils.SortFlattenedList(users.([]interface{}), d.Get("order_by").(string))
 	if err := d.Set("users", users); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func dataSourceWizViewer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the identity the provider is authenticated as. Useful for audit tagging, or to assert that automation runs with the expected least-privilege identity.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the authenticated principal.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the authenticated principal.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the authenticated principal. Empty for service accounts.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the authenticated principal.\n    - Allowed values: %s", utils.SliceOfStringToMDUList(wiz.ViewerType)),
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The permission scopes granted to the authenticated principal.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		ReadContext: dataSourceWizViewerRead,
+	}
+}
+
+// viewerType maps the graphql type name of the viewer to a principal type
+func viewerType(typeName string) string {
+	switch typeName {
+	case "User":
+		return "USER"
+	case "ServiceAccount":
+		return "SERVICE_ACCOUNT"
+	default:
+		return typeName
+	}
+}
+
+func dataSourceWizViewerRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizViewerRead called...")
+
+	// define the graphql query
+	query := `query viewer {
+	    viewer {
+	        __typename
+	        id
+	        name
+	        email
+	        scopes
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := struct{}{}
+
+	// process the request
+	data := &ReadViewerPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "viewer", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.Viewer.ID)
+
+	// set the data source parameters
+	err := d.Set("name", data.Viewer.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("email", data.Viewer.Email)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("type", viewerType(data.Viewer.TypeName))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("scopes", data.Viewer.Scopes)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+func dataSourceWizViewerPermissionCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: "Check that the identity the provider is authenticated as has the permission scopes a configuration needs, so a pipeline fails before apply instead of with a permission error halfway through. A granted `<action>:all` scope, e.g. `create:all`, covers every scope with the same action.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier for the check.  This is a sha1 hash of the authenticated principal and the required scopes.",
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The permission scopes the configuration needs, e.g. `admin:identity_providers`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"require": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail when any of `scopes` is missing.",
+			},
+			"missing_scopes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The required scopes the authenticated principal does not have, in the order of `scopes`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"granted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the authenticated principal has all of `scopes`.",
+			},
+		},
+		ReadContext: dataSourceWizViewerPermissionCheckRead,
+	}
+}
+
+func dataSourceWizViewerPermissionCheckRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "dataSourceWizViewerPermissionCheckRead called...")
+
+	// define the graphql query
+	query := `query viewerPermissionCheck {
+	    viewer {
+	        id
+	        scopes
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := struct{}{}
+
+	// process the request
+	data := &ReadViewerPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "viewer", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// generate the id for this resource
+	// id must be deterministic, so the id is based on a hash of the principal and the required scopes
+	var identifier bytes.Buffer
+	identifier.WriteString(data.Viewer.ID)
+	required := utils.ConvertListToString(d.Get("scopes").([]interface{}))
+	identifier.WriteString(utils.PrettyPrint(required))
+	h := sha1.New()
+	h.Write([]byte(identifier.String()))
+	d.SetId(hex.EncodeToString(h.Sum(nil)))
+
+	missing := missingScopes(required, data.Viewer.Scopes)
+	if len(missing) > 0 && d.Get("require").(bool) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Missing scope %s", strings.Join(missing, ", ")),
+			Detail:   fmt.Sprintf("The identity the provider is authenticated as (%s) does not have the permission scopes %s. Grant them to the service account or role, or authenticate as an identity that has them.", data.Viewer.ID, strings.Join(missing, ", ")),
+		})
+	}
+
+	// set the data source parameters
+	err := d.Set("missing_scopes", missing)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("granted", len(missing) == 0)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// missingScopes returns the required scopes that are not granted, in the order they are required
+// a granted <action>:all scope covers every scope with the same action
+func missingScopes(required []string, granted []string) []string {
+	has := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		has[s] = true
+	}
+
+	missing := make([]string, 0)
+	for _, s := range utils.Unique(required) {
+		action, _, _ := strings.Cut(s, ":")
+		if has[s] || has[action+":all"] {
+			continue
+		}
+		missing = append(missing, s)
+	}
+	return missing
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMissingScopes(t *testing.T) {
+	granted := []string{"read:projects", "create:all", "admin:identity_providers"}
+
+	cases := map[string]struct {
+		required []string
+		expected []string
+	}{
+		"all granted": {
+			required: []string{"read:projects", "admin:identity_providers"},
+			expected: []string{},
+		},
+		"action wildcard": {
+			required: []string{"create:projects", "create:security_scans"},
+			expected: []string{},
+		},
+		"missing in required order": {
+			required: []string{"write:projects", "read:projects", "delete:projects", "write:projects"},
+			expected: []string{"write:projects", "delete:projects"},
+		},
+		"wildcard is not granted by a single scope": {
+			required: []string{"read:all"},
+			expected: []string{"read:all"},
+		},
+	}
+
+	for name, c := range cases {
+		result := missingScopes(c.required, granted)
+		if !reflect.DeepEqual(result, c.expected) {
+			t.Fatalf(
+				"%s: Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				name,
+				result,
+				c.expected,
+			)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestViewerType(t *testing.T) {
+	cases := map[string]string{
+		"User":           "USER",
+		"ServiceAccount": "SERVICE_ACCOUNT",
+		"":               "",
+	}
+
+	for typeName, expected := range cases {
+		result := viewerType(typeName)
+		if result != expected {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				result,
+				expected,
+			)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deletionProtectionSchema returns the deletion_protection argument shared by resources that are costly to destroy by accident
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set to true, destroying the resource fails. Set it to false and apply before destroying the resource. Unlike the `prevent_destroy` lifecycle argument, the value can come from a variable.",
+	}
+}
+
+// deletionProtectionDiags returns an error when the resource being destroyed has deletion_protection enabled
+// the value is read from state, so disabling protection requires an apply before the destroy
+func deletionProtectionDiags(d *schema.ResourceData, resourceType string) diag.Diagnostics {
+	if !d.Get("deletion_protection").(bool) {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot destroy %s with deletion_protection enabled", resourceType),
+			Detail:   fmt.Sprintf("%s %s has deletion_protection set to true. Set deletion_protection to false and apply before destroying it.", resourceType, d.Id()),
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+
+	var tests = []struct {
+		deletionProtection bool
+		expectedRequests   int
+		expectedError      bool
+	}{
+		{
+			deletionProtection: true,
+			expectedRequests:   0,
+			expectedError:      true,
+		},
+		{
+			deletionProtection: false,
+			expectedRequests:   1,
+			expectedError:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		var requests []string
+		m := &config.ProviderConf{
+			HTTPClient: &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(req.Body)
+					requests = append(requests, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"data":{"deleteSAMLIdentityProvider":{"_stub":null}}}`)),
+						Header:     make(http.Header),
+					}, nil
+				}),
+			},
+			Settings: &config.Settings{
+				WizURL: "http://example.com",
+			},
+		}
+
+		d := schema.TestResourceDataRaw(
+			t,
+			resourceWizSAMLIdP().Schema,
+			map[string]interface{}{
+				"name":                "okta",
+				"login_url":           "https://example.okta.com/app/wiz/sso/saml",
+				"certificate":         "certificate",
+				"deletion_protection": tc.deletionProtection,
+			},
+		)
+		d.SetId("okta")
+
+		diags := resourceWizSAMLIdPDelete(ctx, d, m)
+		if diags.HasError() != tc.expectedError {
+			t.Fatalf("Got:\n\n%#v\n\nExpected error: %t\n", diags, tc.expectedError)
+		}
+		if tc.expectedError && !strings.Contains(diags[0].Summary, "deletion_protection") {
+			t.Fatalf("Got:\n\n%#v\n\nExpected a deletion_protection error\n", diags[0].Summary)
+		}
+		if len(requests) != tc.expectedRequests {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				len(requests),
+				tc.expectedRequests,
+			)
+		}
+	}
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+)
+
+//go:generate go test -run TestProviderFieldPaths -update .
+
+// ReadSchemaTypes struct
+type ReadSchemaTypes struct {
+	Schema struct {
+		QueryType    *introspectionTypeRef `json:"queryType"`
+		MutationType *introspectionTypeRef `json:"mutationType"`
+		Types        []*introspectionType  `json:"types"`
+	} `json:"__schema"`
+}
+
+type introspectionType struct {
+	Name   string                `json:"name"`
+	Fields []*introspectionField `json:"fields"`
+}
+
+type introspectionField struct {
+	Name              string                `json:"name"`
+	IsDeprecated      bool                  `json:"isDeprecated"`
+	DeprecationReason string                `json:"deprecationReason"`
+	Type              *introspectionTypeRef `json:"type"`
+}
+
+type introspectionTypeRef struct {
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+// namedType returns the name of the type, unwrapping lists and non-null types
+func (t *introspectionTypeRef) namedType() string {
+	for ; t != nil; t = t.OfType {
+		if t.Name != "" {
+			return t.Name
+		}
+	}
+	return ""
+}
+
+// checkDeprecatedFields introspects the schema and warns about deprecated fields selected by the provider's operations
+// tenants with introspection disabled return an error, which is logged and otherwise ignored
+func checkDeprecatedFields(ctx context.Context, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "checkDeprecatedFields called...")
+
+	// define the graphql query
+	query := `query schemaTypes {
+	    __schema {
+	        queryType {
+	            name
+	        }
+	        mutationType {
+	            name
+	        }
+	        types {
+	            name
+	            fields(includeDeprecated: true) {
+	                name
+	                isDeprecated
+	                deprecationReason
+	                type {
+	                    name
+	                    ofType {
+	                        name
+	                        ofType {
+	                            name
+	                            ofType {
+	                                name
+	                            }
+	                        }
+	                    }
+	                }
+	            }
+	        }
+	    }
+	}`
+
+	// process the request
+	data := &ReadSchemaTypes{}
+	requestDiags := client.ProcessRequest(ctx, m, map[string]interface{}{}, data, query, "schema", "read")
+	if len(requestDiags) > 0 {
+		tflog.Warn(ctx, "Unable to introspect the Wiz schema, skipping the deprecated field check")
+		return diags
+	}
+
+	return deprecatedFieldDiags(data, providerFieldPaths)
+}
+
+// deprecatedFieldDiags returns a warning for each deprecated field selected by the field paths, sorted by type and field name
+// each path is followed through the schema types from the query or mutation root, a path that leaves the schema is not checked further
+func deprecatedFieldDiags(schema *ReadSchemaTypes, paths []string) (diags diag.Diagnostics) {
+	types := make(map[string]*introspectionType)
+	for _, t := range schema.Schema.Types {
+		types[t.Name] = t
+	}
+	roots := map[string]string{
+		"query":    schema.Schema.QueryType.namedType(),
+		"mutation": schema.Schema.MutationType.namedType(),
+	}
+
+	// the first path selecting a deprecated field is named in its warning
+	used := make(map[string]string)
+	deprecated := make(map[string]*introspectionField)
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		current := roots[segments[0]]
+		for _, segment := range segments[1:] {
+			if strings.HasPrefix(segment, "on ") {
+				current = strings.TrimPrefix(segment, "on ")
+				continue
+			}
+			t, ok := types[current]
+			if !ok {
+				break
+			}
+			var field *introspectionField
+			for _, f := range t.Fields {
+				if f.Name == segment {
+					field = f
+					break
+				}
+			}
+			if field == nil {
+				break
+			}
+			if field.IsDeprecated {
+				name := fmt.Sprintf("%s.%s", t.Name, field.Name)
+				if _, ok := used[name]; !ok {
+					used[name] = path
+					deprecated[name] = field
+				}
+			}
+			current = field.Type.namedType()
+		}
+	}
+
+	var names []string
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		reason := deprecated[name].DeprecationReason
+		if reason == "" {
+			reason = "No replacement was given."
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Wiz API field %s is deprecated", name),
+			Detail:   fmt.Sprintf("The provider selects the deprecated field %s (%s), which may be removed in a future Wiz release. %s", name, used[name], reason),
+		})
+	}
+	return diags
+}
+
+// graphQLSelection struct -- a field, inline fragment or fragment spread of a graphql selection set
+type graphQLSelection struct {
+	name     string
+	on       string
+	spread   string
+	children []*graphQLSelection
+}
+
+// graphQLParser struct -- reads the selection sets of a graphql document, arguments and directives are skipped
+type graphQLParser struct {
+	tokens []string
+	pos    int
+}
+
+// graphQLFieldPaths returns the paths of the leaf fields selected by a graphql document, resolving aliases and fragments
+// a path starts with the operation type and names the type condition of inline fragments, e.g. `query.connector.config.on ConnectorConfigAWS.bucketName`
+func graphQLFieldPaths(document string) (paths []string) {
+	p := &graphQLParser{tokens: graphQLTokens(document)}
+
+	type operation struct {
+		kind       string
+		selections []*graphQLSelection
+	}
+	var operations []operation
+	fragments := make(map[string][]*graphQLSelection)
+	for p.peek() != "" {
+		switch t := p.next(); t {
+		case "{":
+			operations = append(operations, operation{"query", p.selectionSet()})
+		case "query", "mutation", "subscription":
+			p.skipTo("{")
+			operations = append(operations, operation{t, p.selectionSet()})
+		case "fragment":
+			name := p.next()
+			p.skipTo("{")
+			fragments[name] = p.selectionSet()
+		default:
+			return paths
+		}
+	}
+
+	for _, o := range operations {
+		paths = append(paths, graphQLSelectionPaths(o.kind, o.selections, fragments, map[string]bool{})...)
+	}
+	return paths
+}
+
+// graphQLSelectionPaths returns the paths of the leaf fields below prefix
+func graphQLSelectionPaths(prefix string, selections []*graphQLSelection, fragments map[string][]*graphQLSelection, expanding map[string]bool) (paths []string) {
+	for _, s := range selections {
+		switch {
+		case s.spread != "":
+			// fragments that spread themselves are expanded once
+			if expanding[s.spread] {
+				continue
+			}
+			expanding[s.spread] = true
+			paths = append(paths, graphQLSelectionPaths(prefix, fragments[s.spread], fragments, expanding)...)
+			delete(expanding, s.spread)
+		case s.name == "":
+			inline := prefix
+			if s.on != "" {
+				inline = fmt.Sprintf("%s.on %s", prefix, s.on)
+			}
+			paths = append(paths, graphQLSelectionPaths(inline, s.children, fragments, expanding)...)
+		case strings.HasPrefix(s.name, "__"):
+			continue
+		case len(s.children) == 0:
+			paths = append(paths, fmt.Sprintf("%s.%s", prefix, s.name))
+		default:
+			paths = append(paths, graphQLSelectionPaths(fmt.Sprintf("%s.%s", prefix, s.name), s.children, fragments, expanding)...)
+		}
+	}
+	return paths
+}
+
+func (p *graphQLParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *graphQLParser) next() string {
+	t := p.peek()
+	if t != "" {
+		p.pos++
+	}
+	return t
+}
+
+// skipTo skips the name, variables and directives of a definition up to and including token
+func (p *graphQLParser) skipTo(token string) {
+	parens := 0
+	for t := p.next(); t != ""; t = p.next() {
+		switch {
+		case t == "(":
+			parens++
+		case t == ")":
+			parens--
+		case t == token && parens == 0:
+			return
+		}
+	}
+}
+
+// skipArguments skips a parenthesized argument list and the directives that follow
+func (p *graphQLParser) skipArguments() {
+	for p.peek() == "(" || p.peek() == "@" {
+		if p.next() == "@" {
+			p.next()
+			continue
+		}
+		parens := 1
+		for parens > 0 && p.peek() != "" {
+			switch p.next() {
+			case "(":
+				parens++
+			case ")":
+				parens--
+			}
+		}
+	}
+}
+
+// selectionSet reads the selections up to the closing brace, the opening brace has been read
+func (p *graphQLParser) selectionSet() (selections []*graphQLSelection) {
+	for {
+		t := p.next()
+		switch {
+		case t == "" || t == "}":
+			return selections
+		case t == "...":
+			s := &graphQLSelection{}
+			switch p.peek() {
+			case "on":
+				p.next()
+				s.on = p.next()
+			case "{", "@":
+			default:
+				s.spread = p.next()
+			}
+			p.skipArguments()
+			if s.spread == "" && p.next() == "{" {
+				s.children = p.selectionSet()
+			}
+			selections = append(selections, s)
+		default:
+			// an alias is followed by a colon and the field name
+			if p.peek() == ":" {
+				p.next()
+				t = p.next()
+			}
+			s := &graphQLSelection{name: t}
+			p.skipArguments()
+			if p.peek() == "{" {
+				p.next()
+				s.children = p.selectionSet()
+			}
+			selections = append(selections, s)
+		}
+	}
+}
+
+// graphQLTokens splits a graphql document into names, punctuators and values, ignoring whitespace, commas and comments
+func graphQLTokens(document string) (tokens []string) {
+	runes := []rune(document)
+	isName := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+	}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r) || r == ',' || r == '\ufeff':
+		case r == '#':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(string(runes[i:]), `"""`):
+			i += 3
+			for i < len(runes) && !strings.HasPrefix(string(runes[i:]), `"""`) {
+				i++
+			}
+			i = min(i+2, len(runes)-1)
+			tokens = append(tokens, string(runes[start:i+1]))
+		case r == '"':
+			for i+1 < len(runes) && runes[i+1] != '"' && runes[i+1] != '\n' {
+				if runes[i+1] == '\\' {
+					i++
+				}
+				i++
+			}
+			i = min(i+1, len(runes)-1)
+			tokens = append(tokens, string(runes[start:i+1]))
+		case strings.HasPrefix(string(runes[i:]), "..."):
+			i += 2
+			tokens = append(tokens, "...")
+		case r == '-' || unicode.IsDigit(r):
+			for i+1 < len(runes) && (isName(runes[i+1]) || runes[i+1] == '.' || runes[i+1] == '+' || runes[i+1] == '-') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i+1]))
+		case isName(r):
+			for i+1 < len(runes) && isName(runes[i+1]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i+1]))
+		default:
+			tokens = append(tokens, string(r))
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+)
+
+var updateFieldPaths = flag.Bool("update", false, "regenerate field_paths_gen.go")
+
+func TestGraphQLFieldPaths(t *testing.T) {
+	query := `query connector (
+	    $id: ID!
+	    $passed: ConfigurationFindingFilters = { result: "{passed}" }
+	){
+	    passed: configurationFindings(
+	        first: 0
+	        filterBy: $passed
+	    ) {
+	        totalCount
+	    }
+	    connector(
+	        id: $id
+	    ) @include(if: true) {
+	        __typename
+	        id
+	        config {
+	            ... on ConnectorConfigAWS {
+	                bucketName
+	            }
+	        }
+	        type {
+	            ...ConnectorTypeFrag
+	        }
+	    }
+	}
+	fragment ConnectorTypeFrag on ConnectorType {
+	    id
+	    name
+	}`
+
+	expected := []string{
+		"query.configurationFindings.totalCount",
+		"query.connector.id",
+		"query.connector.config.on ConnectorConfigAWS.bucketName",
+		"query.connector.type.id",
+		"query.connector.type.name",
+	}
+
+	paths := graphQLFieldPaths(query)
+	if !reflect.DeepEqual(expected, paths) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			paths,
+			expected,
+		)
+	}
+}
+
+// TestProviderFieldPaths compares providerFieldPaths with the fields selected by the operations in the provider source
+// run it with -update to regenerate field_paths_gen.go
+func TestProviderFieldPaths(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operation := regexp.MustCompile("`\\s*(query|mutation)\\b[^`]*`")
+	var paths []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || file == "field_paths_gen.go" {
+			continue
+		}
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, query := range operation.FindAllString(string(source), -1) {
+			paths = append(paths, graphQLFieldPaths(strings.Trim(query, "`"))...)
+		}
+	}
+	paths = utils.Unique(paths)
+	sort.Strings(paths)
+
+	if *updateFieldPaths {
+		var b strings.Builder
+		b.WriteString("// Code generated by TestProviderFieldPaths with -update; DO NOT EDIT.\n\n")
+		b.WriteString("package provider\n\n")
+		b.WriteString("// providerFieldPaths lists the leaf fields selected by the provider's graphql operations, see graphQLFieldPaths\n")
+		b.WriteString("var providerFieldPaths = []string{\n")
+		for _, p := range paths {
+			b.WriteString(fmt.Sprintf("\t%q,\n", p))
+		}
+		b.WriteString("}\n")
+		if err := os.WriteFile("field_paths_gen.go", []byte(b.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(paths, providerFieldPaths) {
+		t.Fatalf("providerFieldPaths is out of date, run go generate ./internal/provider/\n\nMissing:\n\n%#v\n\nStale:\n\n%#v\n", utils.Missing(providerFieldPaths, paths), utils.Missing(paths, providerFieldPaths))
+	}
+}
+
+func TestDeprecatedFieldDiags(t *testing.T) {
+	schema := &ReadSchemaTypes{}
+	schema.Schema.QueryType = &introspectionTypeRef{Name: "Query"}
+	schema.Schema.Types = []*introspectionType{
+		{
+			Name: "Query",
+			Fields: []*introspectionField{
+				{
+					Name: "projects",
+					Type: &introspectionTypeRef{OfType: &introspectionTypeRef{Name: "ProjectConnection"}},
+				},
+				{
+					Name:              "issues",
+					IsDeprecated:      true,
+					DeprecationReason: "Use issuesV2 instead.",
+					Type:              &introspectionTypeRef{Name: "IssueConnection"},
+				},
+			},
+		},
+		{
+			Name: "ProjectConnection",
+			Fields: []*introspectionField{
+				{
+					Name: "nodes",
+					Type: &introspectionTypeRef{OfType: &introspectionTypeRef{OfType: &introspectionTypeRef{Name: "Project"}}},
+				},
+			},
+		},
+		{
+			Name: "Project",
+			Fields: []*introspectionField{
+				{
+					Name: "id",
+					Type: &introspectionTypeRef{Name: "ID"},
+				},
+				{
+					Name:              "businessUnit",
+					IsDeprecated:      true,
+					DeprecationReason: "Use projectOwners instead.",
+					Type:              &introspectionTypeRef{Name: "String"},
+				},
+			},
+		},
+	}
+
+	expected := diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Wiz API field Project.businessUnit is deprecated",
+			Detail:   "The provider selects the deprecated field Project.businessUnit (query.projects.nodes.businessUnit), which may be removed in a future Wiz release. Use projectOwners instead.",
+		},
+	}
+
+	diags := deprecatedFieldDiags(schema, []string{
+		"query.projects.nodes.businessUnit",
+		"query.projects.nodes.id",
+		"query.projects.totalCount",
+		"mutation.createProject.project.id",
+	})
+	if !reflect.DeepEqual(expected, diags) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			diags,
+			expected,
+		)
+	}
+}
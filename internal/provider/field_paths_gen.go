@@ -0,0 +1,531 @@
+// Code generated by TestProviderFieldPaths with -update; DO NOT EDIT.
+
+package provider
+
+// providerFieldPaths lists the leaf fields selected by the provider's graphql operations, see graphQLFieldPaths
+var providerFieldPaths = []string{
+	"mutation.createAutomationRule.automationRule.id",
+	"mutation.createCICDScanPolicy.scanPolicy.builtin",
+	"mutation.createCICDScanPolicy.scanPolicy.id",
+	"mutation.createCloudConfigurationRule.rule.id",
+	"mutation.createConnector.connector.id",
+	"mutation.createControl.control.id",
+	"mutation.createDashboard.dashboard.id",
+	"mutation.createIntegration.integration.id",
+	"mutation.createNotificationRule.notificationRule.id",
+	"mutation.createOutpost.outpost.id",
+	"mutation.createProject.project.id",
+	"mutation.createReport.report.id",
+	"mutation.createReport.report.name",
+	"mutation.createReport.report.params.on ReportParamsGraphQuery.entityOptions.entityType",
+	"mutation.createReport.report.params.on ReportParamsGraphQuery.entityOptions.propertyOptions.key",
+	"mutation.createReport.report.params.on ReportParamsGraphQuery.query",
+	"mutation.createReport.report.project.id",
+	"mutation.createReport.report.project.name",
+	"mutation.createReport.report.runIntervalHours",
+	"mutation.createReport.report.runStartsAt",
+	"mutation.createReport.report.type.description",
+	"mutation.createReport.report.type.id",
+	"mutation.createReport.report.type.name",
+	"mutation.createSAMLIdentityProvider.samlIdentityProvider.id",
+	"mutation.createSecurityFramework.framework.id",
+	"mutation.createServiceAccount.serviceAccount.assignedProjects.id",
+	"mutation.createServiceAccount.serviceAccount.clientId",
+	"mutation.createServiceAccount.serviceAccount.clientSecret",
+	"mutation.createServiceAccount.serviceAccount.createdAt",
+	"mutation.createServiceAccount.serviceAccount.id",
+	"mutation.createServiceAccount.serviceAccount.lastRotatedAt",
+	"mutation.createServiceAccount.serviceAccount.name",
+	"mutation.createServiceAccount.serviceAccount.scopes",
+	"mutation.createServiceAccount.serviceAccount.type",
+	"mutation.createUser.user.id",
+	"mutation.deleteAutomationRule._stub",
+	"mutation.deleteCICDScanPolicy.id",
+	"mutation.deleteCloudConfigurationRule._stub",
+	"mutation.deleteConnector._stub",
+	"mutation.deleteControl._stub",
+	"mutation.deleteDashboard._stub",
+	"mutation.deleteIntegration._stub",
+	"mutation.deleteNotificationRule._stub",
+	"mutation.deleteOutpost._stub",
+	"mutation.deleteProject._stub",
+	"mutation.deleteReport._stub",
+	"mutation.deleteSAMLIdentityProvider._stub",
+	"mutation.deleteSecurityFramework._stub",
+	"mutation.deleteServiceAccount._stub",
+	"mutation.deleteUser._stub",
+	"mutation.updateAutomationRule.automationRule.enabled",
+	"mutation.updateAutomationRule.automationRule.id",
+	"mutation.updateCICDScanPolicy.scanPolicy.id",
+	"mutation.updateCloudConfigurationRule.rule.id",
+	"mutation.updateCloudConfigurationRules.errors.reason",
+	"mutation.updateCloudConfigurationRules.errors.rule.id",
+	"mutation.updateCloudConfigurationRules.failCount",
+	"mutation.updateCloudConfigurationRules.successCount",
+	"mutation.updateConnector.connector.enabled",
+	"mutation.updateConnector.connector.extraConfig",
+	"mutation.updateConnector.connector.id",
+	"mutation.updateConnector.connector.name",
+	"mutation.updateControl.control.id",
+	"mutation.updateControls.errors.control.id",
+	"mutation.updateControls.errors.reason",
+	"mutation.updateControls.failCount",
+	"mutation.updateControls.successCount",
+	"mutation.updateDashboard.dashboard.id",
+	"mutation.updateHostConfigurationRules.errors.reason",
+	"mutation.updateHostConfigurationRules.errors.rule.id",
+	"mutation.updateHostConfigurationRules.failCount",
+	"mutation.updateHostConfigurationRules.successCount",
+	"mutation.updateIntegration.integration.id",
+	"mutation.updateNotificationRule.notificationRule.id",
+	"mutation.updateOutpost.outpost.id",
+	"mutation.updateProject.project.id",
+	"mutation.updateReport.report.id",
+	"mutation.updateReport.report.name",
+	"mutation.updateReport.report.params.on ReportParamsGraphQuery.entityOptions.entityType",
+	"mutation.updateReport.report.params.on ReportParamsGraphQuery.entityOptions.propertyOptions.key",
+	"mutation.updateReport.report.params.on ReportParamsGraphQuery.query",
+	"mutation.updateReport.report.project.id",
+	"mutation.updateReport.report.project.name",
+	"mutation.updateReport.report.runIntervalHours",
+	"mutation.updateReport.report.runStartsAt",
+	"mutation.updateReport.report.type.description",
+	"mutation.updateReport.report.type.id",
+	"mutation.updateReport.report.type.name",
+	"mutation.updateSAMLIdentityProvider.samlIdentityProvider.id",
+	"mutation.updateSAMLIdentityProvider.samlIdentityProvider.mergeGroupsMappingByRole",
+	"mutation.updateSecurityFramework.framework.id",
+	"mutation.updateUser.user.id",
+	"query.auditLogEntries.nodes.action",
+	"query.auditLogEntries.nodes.actionParameters",
+	"query.auditLogEntries.nodes.id",
+	"query.auditLogEntries.nodes.serviceAccount.id",
+	"query.auditLogEntries.nodes.serviceAccount.name",
+	"query.auditLogEntries.nodes.status",
+	"query.auditLogEntries.nodes.timestamp",
+	"query.auditLogEntries.nodes.user.email",
+	"query.auditLogEntries.nodes.user.id",
+	"query.auditLogEntries.nodes.user.name",
+	"query.auditLogEntries.pageInfo.endCursor",
+	"query.auditLogEntries.pageInfo.hasNextPage",
+	"query.auditLogEntries.totalCount",
+	"query.automationRule.actions.actionTemplateParams.on AwsSnsActionTemplateParams.body",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionAddCommentTemplateParams.addIssuesReport",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionAddCommentTemplateParams.comment",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionAddCommentTemplateParams.projectKey",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.alternativeDescriptionField",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.assignee",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.attachEvidenceCSV",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.components",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.customFields",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.description",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.fixVersion",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.issueType",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.labels",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.priority",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.project",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionCreateTicketTemplateParams.fields.summary",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.advancedFields",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.attachEvidenceCSV",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.comment",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.commentOnTransition",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.project",
+	"query.automationRule.actions.actionTemplateParams.on JiraActionTransitionTicketTemplateParams.transitionId",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionCreateTicketTemplateParams.fields.attachEvidenceCSV",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionCreateTicketTemplateParams.fields.customFields",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionCreateTicketTemplateParams.fields.description",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionCreateTicketTemplateParams.fields.summary",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionCreateTicketTemplateParams.fields.tableName",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionUpdateTicketTemplateParams.attachIssuesReport",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionUpdateTicketTemplateParams.fields",
+	"query.automationRule.actions.actionTemplateParams.on ServiceNowActionUpdateTicketTemplateParams.tableName",
+	"query.automationRule.actions.actionTemplateType",
+	"query.automationRule.actions.id",
+	"query.automationRule.actions.integration.id",
+	"query.automationRule.createdAt",
+	"query.automationRule.description",
+	"query.automationRule.enabled",
+	"query.automationRule.filters",
+	"query.automationRule.id",
+	"query.automationRule.name",
+	"query.automationRule.project.id",
+	"query.automationRule.triggerSource",
+	"query.automationRule.triggerType",
+	"query.cicdScanPolicy.builtin",
+	"query.cicdScanPolicy.description",
+	"query.cicdScanPolicy.id",
+	"query.cicdScanPolicy.name",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.builtinIgnoreTagsEnabled",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.countThreshold",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.customIgnoreTags.ignoreAllRules",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.customIgnoreTags.key",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.customIgnoreTags.rules.id",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.customIgnoreTags.value",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.ignoredRules.id",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.securityFrameworks.id",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsIAC.severityThreshold",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsSecrets.countThreshold",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsSecrets.pathAllowList",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsVulnerabilities.ignoreUnfixed",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsVulnerabilities.packageAllowList",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsVulnerabilities.packageCountThreshold",
+	"query.cicdScanPolicy.params.on CICDScanPolicyParamsVulnerabilities.severity",
+	"query.cloudAccounts.nodes.cloudProvider",
+	"query.cloudAccounts.nodes.externalId",
+	"query.cloudAccounts.nodes.id",
+	"query.cloudAccounts.nodes.linkedProjects.id",
+	"query.cloudAccounts.nodes.name",
+	"query.cloudAccounts.nodes.sourceConnectors.id",
+	"query.cloudAccounts.nodes.status",
+	"query.cloudAccounts.pageInfo.endCursor",
+	"query.cloudAccounts.pageInfo.hasNextPage",
+	"query.cloudAccounts.totalCount",
+	"query.cloudConfigurationRule.control.id",
+	"query.cloudConfigurationRule.description",
+	"query.cloudConfigurationRule.enabled",
+	"query.cloudConfigurationRule.functionAsControl",
+	"query.cloudConfigurationRule.iacMatchers.regoCode",
+	"query.cloudConfigurationRule.iacMatchers.type",
+	"query.cloudConfigurationRule.id",
+	"query.cloudConfigurationRule.name",
+	"query.cloudConfigurationRule.opaPolicy",
+	"query.cloudConfigurationRule.remediationInstructions",
+	"query.cloudConfigurationRule.scopeAccounts.id",
+	"query.cloudConfigurationRule.securitySubCategories.id",
+	"query.cloudConfigurationRule.severity",
+	"query.cloudConfigurationRule.targetNativeTypes",
+	"query.cloudConfigurationRules.nodes.builtin",
+	"query.cloudConfigurationRules.nodes.cloudProvider",
+	"query.cloudConfigurationRules.nodes.control.id",
+	"query.cloudConfigurationRules.nodes.description",
+	"query.cloudConfigurationRules.nodes.enabled",
+	"query.cloudConfigurationRules.nodes.externalReferences.id",
+	"query.cloudConfigurationRules.nodes.externalReferences.name",
+	"query.cloudConfigurationRules.nodes.functionAsControl",
+	"query.cloudConfigurationRules.nodes.graphId",
+	"query.cloudConfigurationRules.nodes.hasAutoRemediation",
+	"query.cloudConfigurationRules.nodes.iacMatchers.id",
+	"query.cloudConfigurationRules.nodes.id",
+	"query.cloudConfigurationRules.nodes.name",
+	"query.cloudConfigurationRules.nodes.opaPolicy",
+	"query.cloudConfigurationRules.nodes.remediationInstructions",
+	"query.cloudConfigurationRules.nodes.scopeAccounts.id",
+	"query.cloudConfigurationRules.nodes.securitySubCategories.id",
+	"query.cloudConfigurationRules.nodes.serviceType",
+	"query.cloudConfigurationRules.nodes.severity",
+	"query.cloudConfigurationRules.nodes.shortId",
+	"query.cloudConfigurationRules.nodes.subjectEntityType",
+	"query.cloudConfigurationRules.nodes.supportsNRT",
+	"query.cloudConfigurationRules.nodes.targetNativeTypes",
+	"query.cloudConfigurationRules.pageInfo.endCursor",
+	"query.cloudConfigurationRules.pageInfo.hasNextPage",
+	"query.cloudConfigurationRules.totalCount",
+	"query.cloudOrganizations.nodes.cloudProvider",
+	"query.cloudOrganizations.nodes.externalId",
+	"query.cloudOrganizations.nodes.id",
+	"query.cloudOrganizations.nodes.name",
+	"query.cloudOrganizations.nodes.path",
+	"query.cloudOrganizations.pageInfo.endCursor",
+	"query.cloudOrganizations.pageInfo.hasNextPage",
+	"query.configurationFindings.nodes.evidence.configurationPath",
+	"query.configurationFindings.nodes.evidence.currentValue",
+	"query.configurationFindings.nodes.evidence.expectedValue",
+	"query.configurationFindings.nodes.id",
+	"query.configurationFindings.nodes.resource.id",
+	"query.configurationFindings.nodes.result",
+	"query.configurationFindings.totalCount",
+	"query.connector.authParams",
+	"query.connector.config.on ConnectorConfigAWS.auditLogMonitorEnabled",
+	"query.connector.config.on ConnectorConfigAWS.cloudTrailConfig.bucketName",
+	"query.connector.config.on ConnectorConfigAWS.cloudTrailConfig.bucketSubAccount",
+	"query.connector.config.on ConnectorConfigAWS.cloudTrailConfig.trailOrg",
+	"query.connector.config.on ConnectorConfigAWS.customerRoleARN",
+	"query.connector.config.on ConnectorConfigAWS.diskAnalyzerInFlightDisabled",
+	"query.connector.config.on ConnectorConfigAWS.excludedAccounts",
+	"query.connector.config.on ConnectorConfigAWS.excludedOUs",
+	"query.connector.config.on ConnectorConfigAWS.externalIdNonce",
+	"query.connector.config.on ConnectorConfigAWS.optedInRegions",
+	"query.connector.config.on ConnectorConfigAWS.region",
+	"query.connector.config.on ConnectorConfigAWS.skipOrganizationScan",
+	"query.connector.config.on ConnectorConfigGCP.auditLogMonitorEnabled",
+	"query.connector.config.on ConnectorConfigGCP.auditLogsConfig.pub_sub.subscriptionID",
+	"query.connector.config.on ConnectorConfigGCP.auditLogsConfig.pub_sub.topicName",
+	"query.connector.config.on ConnectorConfigGCP.delegateUser",
+	"query.connector.config.on ConnectorConfigGCP.diskAnalyzerInFlightDisabled",
+	"query.connector.config.on ConnectorConfigGCP.excludedFolders",
+	"query.connector.config.on ConnectorConfigGCP.excludedProjects",
+	"query.connector.config.on ConnectorConfigGCP.folder_id",
+	"query.connector.config.on ConnectorConfigGCP.includedFolders",
+	"query.connector.config.on ConnectorConfigGCP.organization_id",
+	"query.connector.config.on ConnectorConfigGCP.project_id",
+	"query.connector.config.on ConnectorConfigGCP.projects",
+	"query.connector.enabled",
+	"query.connector.extraConfig",
+	"query.connector.id",
+	"query.connector.name",
+	"query.connector.type.authorizeUrls",
+	"query.connector.type.id",
+	"query.connector.type.name",
+	"query.control.description",
+	"query.control.enabled",
+	"query.control.id",
+	"query.control.name",
+	"query.control.query",
+	"query.control.resolutionRecommendation",
+	"query.control.scopeProject.id",
+	"query.control.scopeProject.name",
+	"query.control.scopeQuery",
+	"query.control.securitySubCategories.id",
+	"query.control.securitySubCategories.title",
+	"query.control.severity",
+	"query.dashboard.id",
+	"query.dashboard.isShared",
+	"query.dashboard.name",
+	"query.dashboard.project.id",
+	"query.dashboard.widgets",
+	"query.dataClassifiers.nodes.builtin",
+	"query.dataClassifiers.nodes.category",
+	"query.dataClassifiers.nodes.id",
+	"query.dataClassifiers.nodes.name",
+	"query.dataClassifiers.pageInfo.endCursor",
+	"query.dataClassifiers.pageInfo.hasNextPage",
+	"query.dataClassifiers.totalCount",
+	"query.graphEntity.id",
+	"query.graphEntity.name",
+	"query.graphEntity.projects.id",
+	"query.graphEntity.properties",
+	"query.graphEntity.type",
+	"query.graphSearch.nodes.entities.id",
+	"query.graphSearch.nodes.entities.name",
+	"query.hostConfigurationRule.id",
+	"query.hostConfigurationRule.securitySubCategories.id",
+	"query.hostConfigurationRules.nodes.builtin",
+	"query.hostConfigurationRules.nodes.description",
+	"query.hostConfigurationRules.nodes.directOVAL",
+	"query.hostConfigurationRules.nodes.enabled",
+	"query.hostConfigurationRules.nodes.externalId",
+	"query.hostConfigurationRules.nodes.id",
+	"query.hostConfigurationRules.nodes.name",
+	"query.hostConfigurationRules.nodes.securitySubCategories.id",
+	"query.hostConfigurationRules.nodes.shortName",
+	"query.hostConfigurationRules.nodes.targetPlatforms.id",
+	"query.hostConfigurationRules.pageInfo.endCursor",
+	"query.hostConfigurationRules.pageInfo.hasNextPage",
+	"query.hostConfigurationRules.totalCount",
+	"query.integration.createdAt",
+	"query.integration.id",
+	"query.integration.isAccessibleToAllProjects",
+	"query.integration.name",
+	"query.integration.params.on AwsSNSIntegrationParams.accessConnector.id",
+	"query.integration.params.on AwsSNSIntegrationParams.accessMethod",
+	"query.integration.params.on AwsSNSIntegrationParams.customerRoleARN",
+	"query.integration.params.on AwsSNSIntegrationParams.topicARN",
+	"query.integration.params.on JiraIntegrationParams.authorization.on JiraIntegrationBasicAuthorization.password",
+	"query.integration.params.on JiraIntegrationParams.authorization.on JiraIntegrationBasicAuthorization.username",
+	"query.integration.params.on JiraIntegrationParams.authorization.on JiraIntegrationTokenBearerAuthorization.token",
+	"query.integration.params.on JiraIntegrationParams.onPremConfig.isOnPrem",
+	"query.integration.params.on JiraIntegrationParams.serverType",
+	"query.integration.params.on JiraIntegrationParams.tlsConfig.allowInsecureTLS",
+	"query.integration.params.on JiraIntegrationParams.tlsConfig.clientCertificateAndPrivateKey",
+	"query.integration.params.on JiraIntegrationParams.tlsConfig.serverCA",
+	"query.integration.params.on JiraIntegrationParams.url",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationBasicAuthorization.password",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationBasicAuthorization.username",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationOAuthAuthorization.clientId",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationOAuthAuthorization.clientSecret",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationOAuthAuthorization.password",
+	"query.integration.params.on ServiceNowIntegrationParams.authorization.on ServiceNowIntegrationOAuthAuthorization.username",
+	"query.integration.params.on ServiceNowIntegrationParams.url",
+	"query.integration.project.id",
+	"query.integration.type",
+	"query.integration.updatedAt",
+	"query.integration.usedByRules.id",
+	"query.issuesV2.nodes.entitySnapshot.id",
+	"query.issuesV2.nodes.entitySnapshot.name",
+	"query.issuesV2.nodes.entitySnapshot.type",
+	"query.issuesV2.nodes.id",
+	"query.issuesV2.nodes.severity",
+	"query.issuesV2.nodes.status",
+	"query.issuesV2.pageInfo.endCursor",
+	"query.issuesV2.pageInfo.hasNextPage",
+	"query.issuesV2.totalCount",
+	"query.kubernetesClusters.nodes.cloudAccount.cloudProvider",
+	"query.kubernetesClusters.nodes.cloudAccount.externalId",
+	"query.kubernetesClusters.nodes.cloudAccount.id",
+	"query.kubernetesClusters.nodes.cloudAccount.name",
+	"query.kubernetesClusters.nodes.externalId",
+	"query.kubernetesClusters.nodes.id",
+	"query.kubernetesClusters.nodes.kind",
+	"query.kubernetesClusters.nodes.name",
+	"query.kubernetesClusters.nodes.projects.id",
+	"query.kubernetesClusters.nodes.projects.name",
+	"query.kubernetesClusters.nodes.projects.riskProfile.businessImpact",
+	"query.kubernetesClusters.nodes.projects.slug",
+	"query.kubernetesClusters.pageInfo.endCursor",
+	"query.kubernetesClusters.pageInfo.hasNextPage",
+	"query.kubernetesClusters.totalCount",
+	"query.notificationRule.channel",
+	"query.notificationRule.enabled",
+	"query.notificationRule.filters",
+	"query.notificationRule.id",
+	"query.notificationRule.name",
+	"query.notificationRule.recipients",
+	"query.outpost.config",
+	"query.outpost.enabled",
+	"query.outpost.id",
+	"query.outpost.name",
+	"query.outpost.serviceType",
+	"query.outpost.status",
+	"query.project.ancestorProjects.id",
+	"query.project.archived",
+	"query.project.businessUnit",
+	"query.project.cloudAccountLinks.cloudAccount.externalId",
+	"query.project.cloudAccountLinks.cloudAccount.id",
+	"query.project.cloudAccountLinks.cloudAccount.name",
+	"query.project.cloudAccountLinks.environment",
+	"query.project.cloudAccountLinks.resourceGroups",
+	"query.project.cloudAccountLinks.resourceTags.key",
+	"query.project.cloudAccountLinks.resourceTags.value",
+	"query.project.cloudAccountLinks.shared",
+	"query.project.cloudOrganizationLinks.cloudOrganization.externalId",
+	"query.project.cloudOrganizationLinks.cloudOrganization.id",
+	"query.project.cloudOrganizationLinks.cloudOrganization.name",
+	"query.project.cloudOrganizationLinks.cloudOrganization.path",
+	"query.project.cloudOrganizationLinks.environment",
+	"query.project.cloudOrganizationLinks.resourceGroups",
+	"query.project.cloudOrganizationLinks.resourceTags.key",
+	"query.project.cloudOrganizationLinks.resourceTags.value",
+	"query.project.cloudOrganizationLinks.shared",
+	"query.project.description",
+	"query.project.id",
+	"query.project.identifiers",
+	"query.project.isFolder",
+	"query.project.kubernetesClustersLinks.environment",
+	"query.project.kubernetesClustersLinks.kubernetesCluster.id",
+	"query.project.kubernetesClustersLinks.namespaces",
+	"query.project.kubernetesClustersLinks.shared",
+	"query.project.name",
+	"query.project.projectOwners.email",
+	"query.project.projectOwners.id",
+	"query.project.projectOwners.name",
+	"query.project.riskProfile.businessImpact",
+	"query.project.riskProfile.hasAuthentication",
+	"query.project.riskProfile.hasExposedAPI",
+	"query.project.riskProfile.isActivelyDeveloped",
+	"query.project.riskProfile.isCustomerFacing",
+	"query.project.riskProfile.isInternetFacing",
+	"query.project.riskProfile.isRegulated",
+	"query.project.riskProfile.regulatoryStandards",
+	"query.project.riskProfile.sensitiveDataTypes",
+	"query.project.riskProfile.storesData",
+	"query.project.securityChampions.email",
+	"query.project.securityChampions.id",
+	"query.project.securityChampions.name",
+	"query.project.slug",
+	"query.projects.nodes.archived",
+	"query.projects.nodes.id",
+	"query.projects.nodes.slug",
+	"query.projects.pageInfo.endCursor",
+	"query.projects.pageInfo.hasNextPage",
+	"query.projects.totalCount",
+	"query.report.id",
+	"query.report.name",
+	"query.report.params.on ReportParamsGraphQuery.entityOptions.entityType",
+	"query.report.params.on ReportParamsGraphQuery.entityOptions.propertyOptions.key",
+	"query.report.params.on ReportParamsGraphQuery.query",
+	"query.report.project.id",
+	"query.report.project.name",
+	"query.report.runIntervalHours",
+	"query.report.runStartsAt",
+	"query.report.type.description",
+	"query.report.type.id",
+	"query.report.type.name",
+	"query.samlIdentityProvider.allowManualRoleOverride",
+	"query.samlIdentityProvider.certificate",
+	"query.samlIdentityProvider.domains",
+	"query.samlIdentityProvider.groupMapping.projects.id",
+	"query.samlIdentityProvider.groupMapping.providerGroupId",
+	"query.samlIdentityProvider.groupMapping.role.id",
+	"query.samlIdentityProvider.groupMapping.role.isProjectScoped",
+	"query.samlIdentityProvider.groupMapping.role.scopes",
+	"query.samlIdentityProvider.id",
+	"query.samlIdentityProvider.issuerURL",
+	"query.samlIdentityProvider.loginURL",
+	"query.samlIdentityProvider.logoutURL",
+	"query.samlIdentityProvider.mergeGroupsMappingByRole",
+	"query.samlIdentityProvider.name",
+	"query.samlIdentityProvider.useProviderManagedRoles",
+	"query.samlIdentityProviders.nodes.domains",
+	"query.samlIdentityProviders.nodes.groupMapping.projects.id",
+	"query.samlIdentityProviders.nodes.groupMapping.providerGroupId",
+	"query.samlIdentityProviders.nodes.groupMapping.role.id",
+	"query.samlIdentityProviders.nodes.id",
+	"query.samlIdentityProviders.nodes.name",
+	"query.samlIdentityProviders.pageInfo.endCursor",
+	"query.samlIdentityProviders.pageInfo.hasNextPage",
+	"query.savedGraphQueries.nodes.id",
+	"query.savedGraphQueries.nodes.name",
+	"query.savedGraphQueries.nodes.query",
+	"query.savedGraphQueries.pageInfo.endCursor",
+	"query.savedGraphQueries.pageInfo.hasNextPage",
+	"query.savedGraphQueries.totalCount",
+	"query.securityFramework.categories.analytics.failedCount",
+	"query.securityFramework.categories.analytics.passedCount",
+	"query.securityFramework.categories.description",
+	"query.securityFramework.categories.id",
+	"query.securityFramework.categories.name",
+	"query.securityFramework.categories.subCategories.description",
+	"query.securityFramework.categories.subCategories.id",
+	"query.securityFramework.categories.subCategories.title",
+	"query.securityFramework.description",
+	"query.securityFramework.enabled",
+	"query.securityFramework.id",
+	"query.securityFramework.name",
+	"query.securitySubCategory.id",
+	"query.serviceAccount.assignedProjects.id",
+	"query.serviceAccount.clientId",
+	"query.serviceAccount.clientSecret",
+	"query.serviceAccount.createdAt",
+	"query.serviceAccount.id",
+	"query.serviceAccount.lastRotatedAt",
+	"query.serviceAccount.name",
+	"query.serviceAccount.scopes",
+	"query.serviceAccount.type",
+	"query.serviceAccounts.nodes.assignedProjects.id",
+	"query.serviceAccounts.nodes.clientId",
+	"query.serviceAccounts.nodes.createdAt",
+	"query.serviceAccounts.nodes.id",
+	"query.serviceAccounts.nodes.lastRotatedAt",
+	"query.serviceAccounts.nodes.name",
+	"query.serviceAccounts.nodes.scopes",
+	"query.serviceAccounts.nodes.type",
+	"query.serviceAccounts.pageInfo.endCursor",
+	"query.serviceAccounts.pageInfo.hasNextPage",
+	"query.serviceAccounts.totalCount",
+	"query.user.effectiveAssignedProjects.id",
+	"query.user.effectiveRole.id",
+	"query.user.email",
+	"query.user.id",
+	"query.user.name",
+	"query.userRoles.nodes.id",
+	"query.userRoles.nodes.name",
+	"query.userRoles.nodes.scopes",
+	"query.userRoles.pageInfo.endCursor",
+	"query.userRoles.pageInfo.hasNextPage",
+	"query.users.nodes.effectiveRole.id",
+	"query.users.nodes.effectiveRole.name",
+	"query.users.nodes.effectiveRole.scopes",
+	"query.users.nodes.email",
+	"query.users.nodes.id",
+	"query.users.nodes.identityProvider.name",
+	"query.users.nodes.identityProviderType",
+	"query.users.nodes.name",
+	"query.users.pageInfo.endCursor",
+	"query.users.pageInfo.hasNextPage",
+	"query.users.totalCount",
+	"query.viewer.email",
+	"query.viewer.id",
+	"query.viewer.name",
+	"query.viewer.scopes",
+	"query.viewer.tenant.id",
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
@@ -41,8 +43,8 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"wiz_url": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Wiz api endpoint.  This varies for each Wiz deployment.  See https://docs.wiz.io/wiz-docs/docs/using-the-wiz-api#the-graphql-endpoint. (default: none, environment variable: WIZ_URL)",
+					Optional:    true,
+					Description: "Wiz api endpoint.  This varies for each Wiz deployment.  See https://docs.wiz.io/wiz-docs/docs/using-the-wiz-api#the-graphql-endpoint. Required unless set by the `profile`. (default: none, environment variable: WIZ_URL)",
 					DefaultFunc: schema.EnvDefaultFunc(
 						"WIZ_URL",
 						nil,
@@ -74,8 +76,8 @@ func New(version string) func() *schema.Provider {
 				},
 				"wiz_auth_client_id": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Your application's Client ID. You can find this value on the Settings > Service Accounts page. (default: none, environment variable: WIZ_AUTH_CLIENT_ID)",
+					Optional:    true,
+					Description: "Your application's Client ID. You can find this value on the Settings > Service Accounts page. Required unless set by the `profile`. (default: none, environment variable: WIZ_AUTH_CLIENT_ID)",
 					DefaultFunc: schema.EnvDefaultFunc(
 						"WIZ_AUTH_CLIENT_ID",
 						nil,
@@ -83,14 +85,32 @@ func New(version string) func() *schema.Provider {
 				},
 				"wiz_auth_client_secret": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Your application's Client Secret. You can find this value on the Settings > Service Accounts page. (default: none, environment variable: WIZ_AUTH_CLIENT_SECRET)",
+					Optional:    true,
+					Description: "Your application's Client Secret. You can find this value on the Settings > Service Accounts page. Required unless set by the `profile`. (default: none, environment variable: WIZ_AUTH_CLIENT_SECRET)",
 					DefaultFunc: schema.EnvDefaultFunc(
 						"WIZ_AUTH_CLIENT_SECRET",
 						nil,
 					),
 					Sensitive: true,
 				},
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of a profile in the shared credentials file to take the endpoint and credentials from, so several provider instances can share one credentials source. The profile can set `wiz_url`, `wiz_auth_url`, `wiz_auth_client_id`, `wiz_auth_client_secret`, `wiz_auth_audience` and `tenant_id`; arguments set in the provider block take precedence over the profile, and the profile takes precedence over environment variables. Configuration fails when the profile does not exist. (default: none, environment variable: WIZ_PROFILE)",
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_PROFILE",
+						nil,
+					),
+				},
+				"shared_credentials_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of the shared credentials file read for `profile`. The file has a `[name]` section per profile with `key = value` lines. (default: ~/.wiz/credentials, environment variable: WIZ_SHARED_CREDENTIALS_FILE)",
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_SHARED_CREDENTIALS_FILE",
+						config.DefaultSharedCredentialsFile,
+					),
+				},
 				"wiz_auth_audience": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -100,6 +120,33 @@ func New(version string) func() *schema.Provider {
 						"wiz-api",
 					),
 				},
+				"tenant_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Wiz tenant to operate on, sent as the `X-Wiz-Tenant` header on every API request. Only required for service accounts that span multiple tenants; use provider aliases to manage several tenants with one credential. (default: none, environment variable: WIZ_TENANT_ID)",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IsUUID,
+					),
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_TENANT_ID",
+						nil,
+					),
+				},
+				"extra_headers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: "Additional HTTP headers sent on every Wiz API request, e.g. a routing header required by an API gateway in front of Wiz. Hop-by-hop headers and headers set by the provider (such as `Authorization` and `X-Wiz-Tenant`) are rejected. Values of headers whose name includes `auth`, `cookie`, `key`, `password`, `secret` or `token` are redacted in logs and HTTP dumps.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					ValidateDiagFunc: utils.ValidateHTTPHeaders,
+				},
+				"assume_project_id": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Project the provider is limited to. Wiz scopes API tokens by the projects assigned to a service account, so this must be used with the credentials of a service account assigned to this project only; the provider checks this when it is configured and fails otherwise. Use it to make sure a workspace cannot change other projects.",
+					ValidateDiagFunc: utils.ValidateUUID,
+				},
 				"proxy": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -268,15 +315,225 @@ yLyKQXhw2W2Xs0qLeC1etA+jTGDK4UfLeC0SF7FSi8o5LL21L8IzApar2pR/
 					Default:     10,
 					Description: "Maximum time to wait before retrying, in seconds.",
 				},
+				"retry_max_elapsed_time": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Total time budget for retrying a request, in seconds. A request is not retried when the next wait would take it past the budget; the last error is returned with the number of attempts. Each individual wait is capped by `retry_max_interval`. Set to 0 to disable.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"retry_max_interval": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Maximum time to wait between two retries of a request, in seconds. Overrides `http_client_retry_wait_max`. Set to 0 to use `http_client_retry_wait_max`.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"query_timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Time limit for a query, in seconds, including its retries. Each page of a paginated read has its own limit. Set to 0 to disable.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"mutation_timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Time limit for a mutation, in seconds, including its retries. Mutations are usually quick, so this can be set lower than `query_timeout`. A mutation that times out may still have been applied by Wiz. Set to 0 to disable.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"max_pages": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     1000,
+					Description: "Safety limit on the number of pages read by any paginated query. A query that still has more results after this many pages fails with an error instead of paging indefinitely. Set to 0 to disable.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"read_consistency_retries": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Number of times a resource read is retried, with exponential backoff starting at one second, when the API reports the object as not found. Newly created objects are not always visible to reads immediately; an object that is still not found after the retries is treated as deleted and removed from state. Other errors are not retried. Disabled by default, so a deleted object is removed from state without waiting.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"validate_on_plan": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Check references to existing Wiz objects against the API during plan, and again before create, so typos and stale identifiers fail before apply. Currently checks that the projects of `wiz_saml_idp` group mappings exist and are not archived (the referenced projects are read 50 per request and all missing projects are reported together), and that the `parent_project_id` of `wiz_project` is not one of its descendants.",
+				},
+				"saml_idp_skip_read_after_write": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Skip the read that follows a create or update of `wiz_saml_idp`, including the check that Wiz applied every group mapping project, and keep the configured values in state. This halves the requests of large applies. The tradeoff is that changes Wiz makes to the submitted values, such as dropped projects, only show up at the next refresh or plan. Only `wiz_saml_idp` is affected: its read scans every group mapping, while the other resources read a single object and rely on the read for values that Wiz computes, such as the slug of `wiz_project`.",
+				},
+				"archived_project_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "keep",
+					Description: fmt.Sprintf(
+						"How archived projects referenced by the `projects` of `wiz_saml_idp` group mappings are handled. `keep` sends them to Wiz unchanged, `drop` leaves them out of the mappings sent to Wiz with a warning and does not report them as drift, `error` fails the create or update.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.ArchivedProjectPolicy,
+						),
+					),
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(
+							wiz.ArchivedProjectPolicy,
+							false,
+						),
+					),
+				},
+				"group_mapping_project_limit": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "The largest number of `projects` a `wiz_saml_idp` group mapping may have before create and update act on `group_mapping_project_limit_policy`. Use it to catch mappings that exceed a limit of your Wiz tenant before the request is sent. `0` disables the check.",
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.IntAtLeast(0),
+					),
+				},
+				"group_mapping_project_limit_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "warn",
+					Description: fmt.Sprintf(
+						"How a `wiz_saml_idp` group mapping with more projects than `group_mapping_project_limit` is handled. `warn` sends the mapping with a warning, `error` fails the create or update.\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.GroupMappingProjectLimitPolicy,
+						),
+					),
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(
+							wiz.GroupMappingProjectLimitPolicy,
+							false,
+						),
+					),
+				},
+				"enable_read_batching": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Combine resource reads by id that are issued at the same time (e.g. during a refresh) into a single request using GraphQL aliases, reducing round-trips for large configurations. Reads that fail as part of a batch are retried individually.",
+				},
+				"disable_query_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Disable the in-memory cache for static catalog queries that no resource of the provider modifies (the roles query, which also returns the permission scopes of each role). Useful for debugging.",
+				},
+				"warn_on_deprecated_fields": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Introspect the Wiz API schema when the provider starts and warn about deprecated fields selected by the provider's queries and mutations, including nested fields, naming the replacement when Wiz provides one. Skipped when introspection is disabled on the tenant.",
+				},
+				"strict_response_decoding": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Fail requests whose response contains fields the provider does not model, instead of ignoring them. Use this in strict environments to catch a mismatch between the provider and the tenant API version early. Leave it disabled to stay compatible with new fields added to the Wiz API.",
+				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Reject every mutation sent to the Wiz API, so creates, updates and deletes fail with an error while plans, refreshes and data sources keep working. Use this as a safety switch during change freezes. (default: false, environment variable: WIZ_READ_ONLY)",
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_READ_ONLY",
+						false,
+					),
+				},
+				"forbidden_scope_project_combos": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Permission scopes (e.g. `admin:all`) that must not be granted on all projects. A plan fails when a `wiz_saml_idp` group mapping or a `wiz_user` without projects is assigned a role with any of these scopes. Disabled when unset.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"debug_http_dump_dir": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Directory to write each http request and response to, as timestamped files, for post-mortem debugging. Credentials and secrets are redacted the same way as in the debug log. Disabled when unset. (default: none, environment variable: WIZ_DEBUG_HTTP_DUMP_DIR)",
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_DEBUG_HTTP_DUMP_DIR",
+						nil,
+					),
+				},
+				"stats_output_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "File to write a JSON summary of the Wiz API requests to: total requests, requests per resource type and operation, retries, p50/p95 latency and bytes sent and received. Terraform does not notify providers when an operation ends, so the file is rewritten after every request and holds the totals when Terraform exits. Each provider run writes its own totals, so after `terraform apply` the file covers the apply phase. Disabled when unset. (default: none, environment variable: WIZ_STATS_OUTPUT_FILE)",
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_STATS_OUTPUT_FILE",
+						nil,
+					),
+				},
+				"diagnostic_detail_level": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: fmt.Sprintf(
+						"How much context the errors of failed Wiz API requests include. `minimal` keeps only the errors reported by the API, which suits CI logs. `normal` adds the request ID, and the GraphQL operation and query when debug logging is enabled. `verbose` always adds the request ID, operation, query and the request variables, with secrets redacted. (default: normal, environment variable: WIZ_DIAGNOSTIC_DETAIL_LEVEL)\n    - Allowed values: %s",
+						utils.SliceOfStringToMDUList(
+							wiz.DiagnosticDetailLevel,
+						),
+					),
+					DefaultFunc: schema.EnvDefaultFunc(
+						"WIZ_DIAGNOSTIC_DETAIL_LEVEL",
+						"normal",
+					),
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(
+							wiz.DiagnosticDetailLevel,
+							false,
+						),
+					),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"wiz_cloud_accounts":               dataSourceWizCloudAccounts(),
-				"wiz_cloud_config_rules":           dataSourceWizCloudConfigurationRules(),
-				"wiz_host_config_rules":            dataSourceWizHostConfigurationRules(),
-				"wiz_kubernetes_clusters":          dataSourceWizKubernetesClusters(),
-				"wiz_organizations":                dataSourceWizOrganizations(),
-				"wiz_subscription_resource_groups": dataSourceWizSubscriptionResourceGroups(),
-				"wiz_users":                        dataSourceWizUsers(),
+				"wiz_audit_logs":                       dataSourceWizAuditLogs(),
+				"wiz_automation_triggers":              dataSourceWizAutomationTriggers(),
+				"wiz_cloud_accounts":                   dataSourceWizCloudAccounts(),
+				"wiz_cloud_config_rule_evaluation":     dataSourceWizCloudConfigurationRuleEvaluation(),
+				"wiz_cloud_config_rule_scan_result":    dataSourceWizCloudConfigurationRuleScanResult(),
+				"wiz_cloud_config_rules":               dataSourceWizCloudConfigurationRules(),
+				"wiz_compliance_posture":               dataSourceWizCompliancePosture(),
+				"wiz_control_findings":                 dataSourceWizControlFindings(),
+				"wiz_current_user":                     dataSourceWizViewer(),
+				"wiz_current_user_permission_check":    dataSourceWizViewerPermissionCheck(),
+				"wiz_data_classifier":                  dataSourceWizDataClassifier(),
+				"wiz_entity":                           dataSourceWizEntity(),
+				"wiz_graphql":                          dataSourceWizGraphQL(),
+				"wiz_host_config_rules":                dataSourceWizHostConfigurationRules(),
+				"wiz_kubernetes_clusters":              dataSourceWizKubernetesClusters(),
+				"wiz_login_domains":                    dataSourceWizLoginDomains(),
+				"wiz_organizations":                    dataSourceWizOrganizations(),
+				"wiz_role_project_reach":               dataSourceWizRoleProjectReach(),
+				"wiz_role_scope_template":              dataSourceWizRoleScopeTemplate(),
+				"wiz_roles_map":                        dataSourceWizRolesMap(),
+				"wiz_saml_group_effective_permissions": dataSourceWizSAMLGroupEffectivePermissions(),
+				"wiz_saml_group_mapping_drift":         dataSourceWizSAMLGroupMappingDrift(),
+				"wiz_saml_group_mapping_manifest":      dataSourceWizSAMLGroupMappingManifest(),
+				"wiz_saml_idp_config":                  dataSourceWizSAMLIdPConfig(),
+				"wiz_saved_query":                      dataSourceWizSavedQuery(),
+				"wiz_service_accounts":                 dataSourceWizServiceAccounts(),
+				"wiz_service_status":                   dataSourceWizServiceStatus(),
+				"wiz_subscription_resource_groups":     dataSourceWizSubscriptionResourceGroups(),
+				"wiz_users":                            dataSourceWizUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"wiz_automation_rule_aws_sns":                  resourceWizAutomationRuleAwsSns(),
@@ -285,20 +542,28 @@ yLyKQXhw2W2Xs0qLeC1etA+jTGDK4UfLeC0SF7FSi8o5LL21L8IzApar2pR/
 				"wiz_automation_rule_jira_transition_ticket":   resourceWizAutomationRuleJiraTransitionTicket(),
 				"wiz_automation_rule_jira_add_comment":         resourceWizAutomationRuleJiraAddComment(),
 				"wiz_automation_rule_jira_create_ticket":       resourceWizAutomationRuleJiraCreateTicket(),
+				"wiz_automation_rule_enablement":               resourceWizAutomationRuleEnablement(),
 				"wiz_cicd_scan_policy":                         resourceWizCICDScanPolicy(),
 				"wiz_cloud_config_rule":                        resourceWizCloudConfigurationRule(),
 				"wiz_cloud_config_rule_associations":           resourceWizCloudConfigRuleAssociations(),
+				"wiz_cloud_config_rule_state":                  resourceWizCloudConfigRuleState(),
 				"wiz_control":                                  resourceWizControl(),
 				"wiz_control_associations":                     resourceWizControlAssociations(),
 				"wiz_connector_aws":                            resourceWizConnectorAws(),
 				"wiz_connector_gcp":                            resourceWizConnectorGcp(),
+				"wiz_dashboard":                                resourceWizDashboard(),
+				"wiz_graphql_mutation":                         resourceWizGraphQLMutation(),
 				"wiz_host_config_rule_associations":            resourceWizHostConfigRuleAssociations(),
 				"wiz_integration_aws_sns":                      resourceWizIntegrationAwsSNS(),
 				"wiz_integration_servicenow":                   resourceWizIntegrationServiceNow(),
 				"wiz_integration_jira":                         resourceWizIntegrationJira(),
 				"wiz_report_graph_query":                       resourceWizReportGraphQuery(),
+				"wiz_notification_rule":                        resourceWizNotificationRule(),
+				"wiz_outpost":                                  resourceWizOutpost(),
 				"wiz_project":                                  resourceWizProject(),
+				"wiz_projects":                                 resourceWizProjects(),
 				"wiz_saml_idp":                                 resourceWizSAMLIdP(),
+				"wiz_saml_merge_mode":                          resourceWizSAMLMergeMode(),
 				"wiz_security_framework":                       resourceWizSecurityFramework(),
 				"wiz_service_account":                          resourceWizServiceAccount(),
 				"wiz_user":                                     resourceWizUser(),
@@ -346,25 +611,54 @@ func init() {
 }
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	// the provider meta is built once and shared by every resource of the provider instance
+	// concurrent calls wait for the first one, so a single token is fetched and a single transport is built
+	// only a successful result is kept, a call after a failure builds the meta again with its own context and configuration
+	var mu sync.Mutex
+	var pcfg *config.ProviderConf
+
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		tflog.Info(ctx, "configure called...")
 
-		// Setup a User-Agent for the API client
-		userAgent := p.UserAgent("terraform-provider-wiz", version)
-		tflog.Debug(ctx, fmt.Sprintf("Provider User Agent: %s", userAgent))
+		mu.Lock()
+		defer mu.Unlock()
+		if pcfg != nil {
+			return pcfg, nil
+		}
 
-		tflog.Info(ctx, "configure called...")
-		var diags diag.Diagnostics
-		cfg, err := config.NewConfig(d)
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Unable to configure provider",
-				Detail:   fmt.Sprintf("Error: %s", err),
-			})
+		conf, diags := newProviderConf(ctx, d, version, p)
+		if conf == nil || diags.HasError() {
 			return nil, diags
 		}
-		pcfg, diags := config.NewProviderConf(ctx, cfg, userAgent)
+		pcfg = conf
 		return pcfg, diags
 	}
 }
+
+// newProviderConf builds the provider meta from the provider configuration
+func newProviderConf(ctx context.Context, d *schema.ResourceData, version string, p *schema.Provider) (*config.ProviderConf, diag.Diagnostics) {
+	tflog.Info(ctx, "newProviderConf called...")
+
+	// Setup a User-Agent for the API client
+	userAgent := p.UserAgent("terraform-provider-wiz", version)
+	tflog.Debug(ctx, fmt.Sprintf("Provider User Agent: %s", userAgent))
+
+	var diags diag.Diagnostics
+	cfg, err := config.NewConfig(d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to configure provider",
+			Detail:   fmt.Sprintf("Error: %s", err),
+		})
+		return nil, diags
+	}
+	pcfg, diags := config.NewProviderConf(ctx, cfg, userAgent)
+	if cfg.AssumeProjectID != "" && !diags.HasError() {
+		diags = append(diags, checkAssumedProject(ctx, pcfg)...)
+	}
+	if cfg.WarnOnDeprecatedFields && !diags.HasError() {
+		diags = append(diags, checkDeprecatedFields(ctx, pcfg)...)
+	}
+	return pcfg, diags
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestConfigureConcurrentFirstUse configures the provider from concurrent goroutines, run it with -race
+func TestConfigureConcurrentFirstUse(t *testing.T) {
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+
+	p := New("test")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"wiz_url":                server.URL,
+		"wiz_auth_url":           server.URL,
+		"wiz_auth_client_id":     "client",
+		"wiz_auth_client_secret": "secret",
+	})
+	configureFunc := configure("test", p)
+
+	const callers = 16
+	metas := make([]interface{}, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			meta, diags := configureFunc(context.Background(), d)
+			if diags.HasError() {
+				t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags, nil)
+			}
+			metas[i] = meta
+		}(i)
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&tokenRequests); n != 1 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", n, 1)
+	}
+	for i := range metas {
+		if metas[i] == nil || metas[i] != metas[0] {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", metas[i], metas[0])
+		}
+	}
+}
+
+func TestConfigureRetriesAfterFailure(t *testing.T) {
+	// the first token response cannot be decoded
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&tokenRequests, 1) == 1 {
+			fmt.Fprint(w, `{"access_token":`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+
+	p := New("test")()
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"wiz_url":                server.URL,
+		"wiz_auth_url":           server.URL,
+		"wiz_auth_client_id":     "client",
+		"wiz_auth_client_secret": "secret",
+	})
+	configureFunc := configure("test", p)
+
+	meta, diags := configureFunc(context.Background(), d)
+	if !diags.HasError() || meta != nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected an error\n", diags)
+	}
+
+	meta, diags = configureFunc(context.Background(), d)
+	if diags.HasError() || meta == nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags, nil)
+	}
+
+	again, _ := configureFunc(context.Background(), d)
+	if again != meta || atomic.LoadInt32(&tokenRequests) != 2 {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", atomic.LoadInt32(&tokenRequests), 2)
+	}
+}
@@ -90,10 +90,11 @@ func resourceWizAutomationRuleAwsSns() *schema.Resource {
 				Default:     true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Wiz internal ID for a project.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Wiz internal ID for a project.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"action_id": {
 				Type:        schema.TypeString,
@@ -101,9 +102,10 @@ func resourceWizAutomationRuleAwsSns() *schema.Resource {
 				Description: "Wiz internal ID for the action.",
 			},
 			"integration_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_aws_sns.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_aws_sns.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"aws_sns_body": {
 				Type:        schema.TypeString,
@@ -234,7 +236,7 @@ func resourceWizAutomationRuleAwsSNSRead(ctx context.Context, d *schema.Resource
 		},
 	}
 
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "automation_rule_aws_sns", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "automation_rule_aws_sns", func() bool { return data.AutomationRule.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
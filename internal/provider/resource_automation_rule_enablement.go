@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizAutomationRuleEnablement() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage only the enabled state of an existing automation rule. This allows the rule content to be owned outside Terraform (or by another configuration) while Terraform controls activation. Destroying this resource removes it from state and leaves the rule unchanged.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier for this object (same as `rule_id`).",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The automation rule identifier.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IsUUID,
+				),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the automation rule is enabled.",
+			},
+		},
+		CreateContext: resourceWizAutomationRuleEnablementCreate,
+		ReadContext:   resourceWizAutomationRuleEnablementRead,
+		UpdateContext: resourceWizAutomationRuleEnablementUpdate,
+		DeleteContext: resourceWizAutomationRuleEnablementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func setAutomationRuleEnabled(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "setAutomationRuleEnabled called...")
+
+	// define the graphql query
+	query := `mutation updateAutomationRule($input: UpdateAutomationRuleInput!) {
+	  updateAutomationRule(
+	    input: $input
+	  ) {
+	    automationRule {
+	      id
+	      enabled
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	// only the enabled field is patched, all other patch fields are omitted
+	vars := &wiz.UpdateAutomationRuleInput{}
+	vars.ID = d.Get("rule_id").(string)
+	vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+
+	// process the request
+	data := &UpdateAutomationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "automation_rule_enablement", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceWizAutomationRuleEnablementCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleEnablementCreate called...")
+
+	diags = setAutomationRuleEnabled(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(d.Get("rule_id").(string))
+
+	return resourceWizAutomationRuleEnablementRead(ctx, d, m)
+}
+
+func resourceWizAutomationRuleEnablementRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleEnablementRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query automationRule (
+	  $id: ID!
+	){
+	  automationRule(
+	    id: $id
+	  ){
+	    id
+	    enabled
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadAutomationRulePayload{}
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "automation_rule_enablement", func() bool { return data.AutomationRule.ID != "" })
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.AutomationRule.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("rule_id", data.AutomationRule.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.AutomationRule.Enabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizAutomationRuleEnablementUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleEnablementUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = setAutomationRuleEnabled(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizAutomationRuleEnablementRead(ctx, d, m)
+}
+
+func resourceWizAutomationRuleEnablementDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizAutomationRuleEnablementDelete called...")
+
+	// the automation rule is owned elsewhere, so it is left in its current state
+	d.SetId("")
+
+	return diags
+}
@@ -90,10 +90,11 @@ func resourceWizAutomationRuleJiraAddComment() *schema.Resource {
 				Default:     true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Wiz internal ID for a project.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Wiz internal ID for a project.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"action_id": {
 				Type:        schema.TypeString,
@@ -101,9 +102,10 @@ func resourceWizAutomationRuleJiraAddComment() *schema.Resource {
 				Description: "Wiz internal ID for the action.",
 			},
 			"integration_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_jira.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_jira.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"jira_project_key": {
 				Type:        schema.TypeString,
@@ -248,7 +250,7 @@ func resourceWizAutomationRuleJiraAddCommentRead(ctx context.Context, d *schema.
 			Actions: automationRuleActions,
 		},
 	}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "automation_rule_jira_add_comment", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "automation_rule_jira_add_comment", func() bool { return data.AutomationRule.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
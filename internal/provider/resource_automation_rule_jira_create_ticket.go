@@ -90,10 +90,11 @@ func resourceWizAutomationRuleJiraCreateTicket() *schema.Resource {
 				Default:     true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Wiz internal ID for a project.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Wiz internal ID for a project.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"action_id": {
 				Type:        schema.TypeString,
@@ -101,9 +102,10 @@ func resourceWizAutomationRuleJiraCreateTicket() *schema.Resource {
 				Description: "Wiz internal ID for the action.",
 			},
 			"integration_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_jira.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_jira.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"jira_summary": {
 				Type:        schema.TypeString,
@@ -332,7 +334,7 @@ func resourceWizAutomationRuleJiraCreateTicketRead(ctx context.Context, d *schem
 		},
 	}
 
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "automation_rule_jira_create_ticket", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "automation_rule_jira_create_ticket", func() bool { return data.AutomationRule.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -90,10 +90,11 @@ func resourceWizAutomationRuleServiceNowCreateTicket() *schema.Resource {
 				Default:     true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Wiz internal ID for a project.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Wiz internal ID for a project.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"action_id": {
 				Type:        schema.TypeString,
@@ -101,9 +102,10 @@ func resourceWizAutomationRuleServiceNowCreateTicket() *schema.Resource {
 				Description: "Wiz internal ID for the action.",
 			},
 			"integration_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_aws_sns.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Wiz identifier for the Integration to leverage for this action. Must be resource type integration_aws_sns.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"servicenow_table_name": {
 				Type:        schema.TypeString,
@@ -274,7 +276,7 @@ func resourceWizAutomationRuleServiceNowCreateTicketRead(ctx context.Context, d
 		},
 	}
 
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "automation_rule_servicenow_create_ticket", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "automation_rule_servicenow_create_ticket", func() bool { return data.AutomationRule.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -579,7 +579,7 @@ func resourceWizCICDScanPolicyRead(ctx context.Context, d *schema.ResourceData,
 	// this query returns http 200 with a payload that contains errors and a null data body
 	// error message: oops! an internal error has occurred. for reference purposes, this is your request id
 	data := &ReadCICDScanPolicyPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "cicd_scan_policy", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "cicd_scan_policy", func() bool { return data.CICDScanPolicy.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -302,7 +302,7 @@ func resourceWizCloudConfigurationRuleRead(ctx context.Context, d *schema.Resour
 	// this query returns http 200 with a payload that contains errors and a null data body
 	// error message: record not found for id
 	data := &ReadCloudConfigurationRulePayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "cloud_config_rule", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "cloud_config_rule", func() bool { return data.CloudConfigurationRule.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
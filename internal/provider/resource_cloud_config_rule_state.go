@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// cloudConfigRuleStateBatchSize is the maximum number of rules sent in a single updateCloudConfigurationRules mutation
+const cloudConfigRuleStateBatchSize = 100
+
+func resourceWizCloudConfigRuleState() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the enabled state of a set of cloud configuration rules in bulk. Only the enabled state is managed; rule content is left untouched. Rules removed from `rule_ids`, or all rules when this resource is destroyed, keep their current state.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Internal identifier for the rule state.",
+				Computed:    true,
+			},
+			"rule_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Set of cloud configuration rule IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the cloud configuration rules are enabled.",
+			},
+		},
+		CreateContext: resourceWizCloudConfigRuleStateCreate,
+		ReadContext:   resourceWizCloudConfigRuleStateRead,
+		UpdateContext: resourceWizCloudConfigRuleStateUpdate,
+		DeleteContext: resourceWizCloudConfigRuleStateDelete,
+	}
+}
+
+// setCloudConfigRulesEnabled sets the enabled state of the given rules, in batches
+func setCloudConfigRulesEnabled(ctx context.Context, m interface{}, ruleIDs []string, enabled bool) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "setCloudConfigRulesEnabled called...")
+
+	// define the graphql query
+	mutation := `mutation UpdateCloudConfigurationRulesInput(
+	  $input: UpdateCloudConfigurationRulesInput!
+	) {
+	  updateCloudConfigurationRules(
+	    input: $input
+	  ) {
+	    successCount
+	    failCount
+	    errors {
+	      reason
+	      rule {
+	        id
+	      }
+	    }
+	  }
+	}`
+
+	for _, chunk := range utils.ChunkStrings(ruleIDs, cloudConfigRuleStateBatchSize) {
+		// populate the graphql variables
+		mvars := &wiz.UpdateCloudConfigurationRulesInput{}
+		mvars.IDs = chunk
+		mvars.Patch = &wiz.UpdateCloudConfigurationRulesPatch{
+			Enabled: utils.ConvertBoolToPointer(enabled),
+		}
+
+		// process the request
+		mdata := &UpdateCloudConfigurationRules{}
+		requestDiags := client.ProcessRequest(ctx, m, mvars, mdata, mutation, "cloud_config_rule_state", "update")
+		diags = append(diags, requestDiags...)
+		if len(diags) > 0 {
+			return diags
+		}
+
+		// error handling
+		if mdata.UpdateCloudConfigurationRules.FailCount > 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Error encountered during operation: %s", utils.PrettyPrint(mdata.UpdateCloudConfigurationRules.Errors)))
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error during UpdateCloudConfigurationRules: %d", mdata.UpdateCloudConfigurationRules.FailCount),
+				Detail:   fmt.Sprintf("Details: %s", utils.PrettyPrint(mdata.UpdateCloudConfigurationRules.Errors)),
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceWizCloudConfigRuleStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizCloudConfigRuleStateCreate called...")
+
+	diags = setCloudConfigRulesEnabled(ctx, m, utils.ConvertListToString(d.Get("rule_ids").(*schema.Set).List()), d.Get("enabled").(bool))
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// generate an id for this resource
+	d.SetId(uuid.New().String())
+
+	return resourceWizCloudConfigRuleStateRead(ctx, d, m)
+}
+
+func resourceWizCloudConfigRuleStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizCloudConfigRuleStateRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query cloudConfigurationRules(
+	  $first: Int
+	  $after: String
+	  $filterBy: CloudConfigurationRuleFilters
+	){
+	  cloudConfigurationRules(
+	    first: $first
+	    after: $after
+	    filterBy: $filterBy
+	  ) {
+	    nodes {
+	      id
+	      enabled
+	    }
+	    pageInfo {
+	      endCursor
+	      hasNextPage
+	    }
+	  }
+	}`
+
+	ruleIDs := utils.ConvertListToString(d.Get("rule_ids").(*schema.Set).List())
+	var allData []interface{}
+	for _, chunk := range utils.ChunkStrings(ruleIDs, cloudConfigRuleStateBatchSize) {
+		// populate the graphql variables
+		vars := &internal.QueryVariables{}
+		vars.First = cloudConfigRuleStateBatchSize
+		vars.FilterBy = &wiz.CloudConfigurationRuleFilters{
+			ID: chunk,
+		}
+
+		// process the request
+		data := &ReadCloudConfigurationRules{}
+		requestDiags, chunkData := client.ProcessPagedRequest(ctx, m, vars, data, query, "cloud_config_rule_state", "read", 0)
+		diags = append(diags, requestDiags...)
+		if len(diags) > 0 {
+			return diags
+		}
+		allData = append(allData, chunkData...)
+	}
+
+	// keep only the rules that exist and are in the desired state, so any drift is planned as an addition
+	reconciled := reconcileCloudConfigRuleState(ctx, allData, d.Get("enabled").(bool))
+	tflog.Debug(ctx, fmt.Sprintf("Reconciled rule ids: %s", utils.PrettyPrint(reconciled)))
+
+	err := d.Set("rule_ids", reconciled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// reconcileCloudConfigRuleState returns the ids of the rules that are in the desired enabled state
+func reconcileCloudConfigRuleState(ctx context.Context, rules []interface{}, enabled bool) []string {
+	tflog.Info(ctx, "reconcileCloudConfigRuleState called...")
+
+	var output = make([]string, 0)
+	for _, a := range rules {
+		for _, b := range a.(*ReadCloudConfigurationRules).CloudConfigurationRules.Nodes {
+			if b.Enabled != nil && *b.Enabled == enabled {
+				output = append(output, b.ID)
+			}
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+func resourceWizCloudConfigRuleStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizCloudConfigRuleStateUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// when the desired state changes every rule is updated, otherwise only the rules added to the set
+	ruleIDs := utils.ConvertListToString(d.Get("rule_ids").(*schema.Set).List())
+	if !d.HasChange("enabled") {
+		o, n := d.GetChange("rule_ids")
+		ruleIDs = utils.ConvertListToString(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+	}
+
+	if len(ruleIDs) > 0 {
+		diags = setCloudConfigRulesEnabled(ctx, m, ruleIDs, d.Get("enabled").(bool))
+		if len(diags) > 0 {
+			return diags
+		}
+	}
+
+	return resourceWizCloudConfigRuleStateRead(ctx, d, m)
+}
+
+func resourceWizCloudConfigRuleStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizCloudConfigRuleStateDelete called...")
+
+	// the rules are left in their current state
+	d.SetId("")
+
+	return diags
+}
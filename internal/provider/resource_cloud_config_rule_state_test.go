@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestReconcileCloudConfigRuleState(t *testing.T) {
+	ctx := context.Background()
+
+	expected := []string{
+		"2b3f4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+		"9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+	}
+
+	var rules = []interface{}{
+		&ReadCloudConfigurationRules{
+			CloudConfigurationRules: wiz.CloudConfigurationRuleConnection{
+				Nodes: []*wiz.CloudConfigurationRule{
+					{
+						ID:      "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+						Enabled: utils.ConvertBoolToPointer(true),
+					},
+					{
+						ID:      "5c4b3a2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d",
+						Enabled: utils.ConvertBoolToPointer(false),
+					},
+				},
+			},
+		},
+		&ReadCloudConfigurationRules{
+			CloudConfigurationRules: wiz.CloudConfigurationRuleConnection{
+				Nodes: []*wiz.CloudConfigurationRule{
+					{
+						ID:      "2b3f4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+						Enabled: utils.ConvertBoolToPointer(true),
+					},
+				},
+			},
+		},
+	}
+
+	reconciled := reconcileCloudConfigRuleState(ctx, rules, true)
+
+	if !reflect.DeepEqual(reconciled, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			reconciled,
+			expected,
+		)
+	}
+}
+
+func TestCloudConfigRuleStateBatches(t *testing.T) {
+	ruleIDs := make([]string, 0)
+	for i := 0; i < 2*cloudConfigRuleStateBatchSize+1; i++ {
+		ruleIDs = append(ruleIDs, "rule")
+	}
+
+	chunks := utils.ChunkStrings(ruleIDs, cloudConfigRuleStateBatchSize)
+
+	if len(chunks) != 3 || len(chunks[0]) != cloudConfigRuleStateBatchSize || len(chunks[2]) != 1 {
+		t.Fatalf("Got %d chunks, expected 3 chunks of at most %d rules", len(chunks), cloudConfigRuleStateBatchSize)
+	}
+}
@@ -234,7 +234,7 @@ func resourceWizConnectorAwsRead(ctx context.Context, d *schema.ResourceData, m
 
 	// process the request
 	data := &ReadConnectorPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "connector", func() bool { return data.Connector.ID != "" })
 	diags = append(diags, requestDiags...,
 	)
 	if len(diags) > 0 {
@@ -246,7 +246,7 @@ func resourceWizConnectorGcpRead(ctx context.Context, d *schema.ResourceData, m
 
 	// process the request
 	data := &ReadConnectorPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "connector", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "connector", func() bool { return data.Connector.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
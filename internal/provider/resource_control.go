@@ -230,7 +230,7 @@ func resourceWizControlRead(ctx context.Context, d *schema.ResourceData, m inter
 	// this query returns http 200 with a payload that contains errors and a null data body
 	// error message: oops! an internal error has occurred. for reference purposes, this is your request id
 	data := &ReadControlPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "control", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "control", func() bool { return data.Control.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
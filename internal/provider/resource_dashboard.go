@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description: "Custom dashboards present a set of widgets, such as issue and resource counts, to Wiz users.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Wiz internal identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The dashboard name.",
+			},
+			"widgets": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The dashboard widgets and their layout, as a JSON array. The value is stored as normalized JSON, and a change in the order of the widgets is not reported as drift.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+				StateFunc: func(v interface{}) string {
+					normalized, err := utils.NormalizeJSON(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return normalized
+				},
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return dashboardWidgetsEqual(oldValue, newValue)
+				},
+			},
+			"shared": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the dashboard is shared with other users. Dashboards that are not shared are only visible to their owner.",
+			},
+			"project_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The project the dashboard belongs to (changing this requires re-creating the dashboard). Defaults to all projects.",
+				ValidateDiagFunc: utils.ValidateUUID,
+			},
+		},
+		CreateContext: resourceWizDashboardCreate,
+		ReadContext:   resourceWizDashboardRead,
+		UpdateContext: resourceWizDashboardUpdate,
+		DeleteContext: resourceWizDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// dashboardWidgetsEqual compares two widget lists as sets, ignoring formatting, key order and widget order
+func dashboardWidgetsEqual(a, b string) bool {
+	var aw, bw []interface{}
+	if json.Unmarshal([]byte(a), &aw) != nil || json.Unmarshal([]byte(b), &bw) != nil {
+		var av, bv interface{}
+		_ = json.Unmarshal([]byte(a), &av)
+		_ = json.Unmarshal([]byte(b), &bv)
+		return reflect.DeepEqual(av, bv)
+	}
+	if len(aw) != len(bw) {
+		return false
+	}
+
+	// compare the widgets by their canonical encoding
+	canonical := func(widgets []interface{}) []string {
+		output := make([]string, 0, len(widgets))
+		for _, w := range widgets {
+			encoded, _ := json.Marshal(w)
+			output = append(output, string(encoded))
+		}
+		sort.Strings(output)
+		return output
+	}
+	return reflect.DeepEqual(canonical(aw), canonical(bw))
+}
+
+// CreateDashboard struct
+type CreateDashboard struct {
+	CreateDashboard wiz.CreateDashboardPayload `json:"createDashboard"`
+}
+
+func resourceWizDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizDashboardCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateDashboard (
+	    $input: CreateDashboardInput!
+	) {
+	    createDashboard(
+	        input: $input
+	    ) {
+	        dashboard {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateDashboardInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Widgets = json.RawMessage(d.Get("widgets").(string))
+	vars.IsShared = utils.ConvertBoolToPointer(d.Get("shared").(bool))
+	vars.ProjectID = d.Get("project_id").(string)
+
+	// process the request
+	data := &CreateDashboard{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "dashboard", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateDashboard.Dashboard.ID)
+
+	return resourceWizDashboardRead(ctx, d, m)
+}
+
+// ReadDashboardPayload struct
+type ReadDashboardPayload struct {
+	Dashboard wiz.Dashboard `json:"dashboard"`
+}
+
+func resourceWizDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizDashboardRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query dashboard ($id: ID!){
+	    dashboard(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        isShared
+	        widgets
+	        project {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadDashboardPayload{}
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "dashboard", func() bool { return data.Dashboard.ID != "" })
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Dashboard.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Dashboard.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("shared", data.Dashboard.IsShared)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// keep the configured widgets when wiz only reordered them
+	widgets, err := utils.NormalizeJSON(string(data.Dashboard.Widgets))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if dashboardWidgetsEqual(d.Get("widgets").(string), widgets) {
+		widgets = d.Get("widgets").(string)
+	}
+	err = d.Set("widgets", widgets)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	projectID := ""
+	if data.Dashboard.Project != nil {
+		projectID = data.Dashboard.Project.ID
+	}
+	err = d.Set("project_id", projectID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// UpdateDashboard struct
+type UpdateDashboard struct {
+	UpdateDashboard wiz.UpdateDashboardPayload `json:"updateDashboard"`
+}
+
+func resourceWizDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizDashboardUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateDashboard (
+	    $input: UpdateDashboardInput!
+	) {
+	    updateDashboard(
+	        input: $input
+	    ) {
+	        dashboard {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateDashboardInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Widgets = json.RawMessage(d.Get("widgets").(string))
+	vars.Patch.IsShared = utils.ConvertBoolToPointer(d.Get("shared").(bool))
+
+	// process the request
+	data := &UpdateDashboard{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "dashboard", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizDashboardRead(ctx, d, m)
+}
+
+// DeleteDashboard struct
+type DeleteDashboard struct {
+	DeleteDashboard wiz.DeleteDashboardPayload `json:"deleteDashboard"`
+}
+
+func resourceWizDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizDashboardDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteDashboard (
+	    $input: DeleteDashboardInput!
+	) {
+	    deleteDashboard(
+	        input: $input
+	    ) {
+	        _stub
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteDashboardInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteDashboard{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "dashboard", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
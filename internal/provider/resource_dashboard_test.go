@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestDashboardWidgetsEqual(t *testing.T) {
+	widgets := `[{"type": "ISSUES_COUNT", "position": {"x": 0, "y": 0}}, {"type": "RESOURCES_COUNT", "position": {"x": 1, "y": 0}}]`
+
+	tests := []struct {
+		other    string
+		expected bool
+	}{
+		// formatting and key order are ignored
+		{`[{"position":{"y":0,"x":0},"type":"ISSUES_COUNT"},{"position":{"y":0,"x":1},"type":"RESOURCES_COUNT"}]`, true},
+		// widget order is ignored
+		{`[{"type": "RESOURCES_COUNT", "position": {"x": 1, "y": 0}}, {"type": "ISSUES_COUNT", "position": {"x": 0, "y": 0}}]`, true},
+		// a moved widget is a change
+		{`[{"type": "ISSUES_COUNT", "position": {"x": 0, "y": 1}}, {"type": "RESOURCES_COUNT", "position": {"x": 1, "y": 0}}]`, false},
+		// a removed widget is a change
+		{`[{"type": "ISSUES_COUNT", "position": {"x": 0, "y": 0}}]`, false},
+	}
+
+	for _, tc := range tests {
+		if dashboardWidgetsEqual(widgets, tc.other) != tc.expected {
+			t.Fatalf("Got:\n\n%t\n\nExpected:\n\n%t\n\nfor %s", !tc.expected, tc.expected, tc.other)
+		}
+	}
+}
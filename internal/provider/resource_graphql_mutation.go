@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWizGraphQLMutation() *schema.Resource {
+	return &schema.Resource{
+		Description: "**Advanced, unsupported.** Run a raw GraphQL mutation against the Wiz API when the resource is created, and optionally another when it is destroyed. Use this for one-off operations the provider does not model yet. The provider does not read the affected objects back, so drift is not detected, and you are responsible for making the mutations safe to repeat. Changing `create_query` or `variables` runs `delete_query` and then `create_query` again.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier generated by the provider.",
+			},
+			"create_query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GraphQL mutation to run on create.",
+			},
+			"delete_query": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GraphQL mutation to run on destroy. When unset, destroying the resource only removes it from state.",
+			},
+			"variables": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The variables for both mutations, as a JSON object.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The `data` of the `create_query` response, as normalized JSON. Decode it with `jsondecode()`.",
+			},
+		},
+		CreateContext: resourceWizGraphQLMutationCreate,
+		ReadContext:   resourceWizGraphQLMutationRead,
+		UpdateContext: resourceWizGraphQLMutationUpdate,
+		DeleteContext: resourceWizGraphQLMutationDelete,
+	}
+}
+
+func resourceWizGraphQLMutationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizGraphQLMutationCreate called...")
+
+	// process the request
+	result, requestDiags := processRawGraphQLRequest(ctx, m, d.Get("create_query").(string), d.Get("variables").(string), "graphql_mutation", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(id.UniqueId())
+
+	err := d.Set("result", result)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return resourceWizGraphQLMutationRead(ctx, d, m)
+}
+
+// resourceWizGraphQLMutationRead keeps the state as is, the objects affected by the mutation are not known to the provider
+func resourceWizGraphQLMutationRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizGraphQLMutationRead called...")
+
+	return diags
+}
+
+// resourceWizGraphQLMutationUpdate only stores a new delete_query, nothing is sent to Wiz
+func resourceWizGraphQLMutationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizGraphQLMutationUpdate called...")
+
+	return resourceWizGraphQLMutationRead(ctx, d, m)
+}
+
+func resourceWizGraphQLMutationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizGraphQLMutationDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	deleteQuery := d.Get("delete_query").(string)
+	if deleteQuery == "" {
+		return diags
+	}
+
+	// process the request
+	_, requestDiags := processRawGraphQLRequest(ctx, m, deleteQuery, d.Get("variables").(string), "graphql_mutation", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
@@ -35,10 +35,11 @@ func resourceWizIntegrationAwsSNS() *schema.Resource {
 				Computed:    true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The project this action is scoped to.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The project this action is scoped to.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"scope": {
 				Type:     schema.TypeString,
@@ -196,7 +197,7 @@ func resourceWizIntegrationAwsSNSRead(ctx context.Context, d *schema.ResourceDat
 	data := &ReadIntegrationPayload{}
 	params := &wiz.AwsSNSIntegrationParams{}
 	data.Integration.Params = params
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_aws_sns", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "integration_aws_sns", func() bool { return data.Integration.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
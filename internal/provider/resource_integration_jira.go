@@ -35,10 +35,11 @@ func resourceWizIntegrationJira() *schema.Resource {
 				Computed:    true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The project this action is scoped to.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The project this action is scoped to.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"scope": {
 				Type:     schema.TypeString,
@@ -124,6 +125,11 @@ func resourceWizIntegrationJira() *schema.Resource {
 					nil,
 				),
 			},
+			"credential_rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value, such as a secret version or rotation date. Changing it updates the integration in place and sends the currently configured credentials to Wiz, so rotated secrets are applied without recreating the integration and breaking the automation rules that use it. Only the new credentials are kept in state.",
+			},
 		},
 		CreateContext: resourceWizIntegrationJiraCreate,
 		ReadContext:   resourceWizIntegrationJiraRead,
@@ -244,7 +250,7 @@ func resourceWizIntegrationJiraRead(ctx context.Context, d *schema.ResourceData,
 	data := &ReadIntegrationPayload{}
 	params := &wiz.JiraIntegrationParams{}
 	data.Integration.Params = params
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_jira", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "integration_jira", func() bool { return data.Integration.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -331,6 +337,22 @@ func resourceWizIntegrationJiraUpdate(ctx context.Context, d *schema.ResourceDat
 	}`
 
 	// populate the graphql variables
+	vars := getJiraIntegrationUpdateVar(d)
+
+	// process the request
+	data := &UpdateIntegration{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_jira", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+// getJiraIntegrationUpdateVar returns the update input for the integration, including the configured credentials
+// credentials are sent on every update, which is what applies a rotation when credential_rotation_trigger changes
+func getJiraIntegrationUpdateVar(d *schema.ResourceData) *wiz.UpdateIntegrationInput {
 	vars := &wiz.UpdateIntegrationInput{}
 	vars.ID = d.Id()
 	vars.Patch.Name = d.Get("name").(string)
@@ -345,13 +367,5 @@ func resourceWizIntegrationJiraUpdate(ctx context.Context, d *schema.ResourceDat
 	vars.Patch.Params.Jira.Authorization.Password = d.Get("jira_password").(string)
 	vars.Patch.Params.Jira.Authorization.PersonalAccessToken = d.Get("jira_pat").(string)
 
-	// process the request
-	data := &UpdateIntegration{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_jira", "update")
-	diags = append(diags, requestDiags...)
-	if len(diags) > 0 {
-		return diags
-	}
-
-	return diags
+	return vars
 }
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestGetJiraIntegrationUpdateVar(t *testing.T) {
+	// rotating credentials must update the integration in place
+	if resourceWizIntegrationJira().Schema["credential_rotation_trigger"].ForceNew {
+		t.Fatalf("credential_rotation_trigger must not force a new integration")
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizIntegrationJira().Schema,
+		map[string]interface{}{
+			"name":                        "jira",
+			"jira_url":                    "https://example.atlassian.net",
+			"jira_username":               "automation@example.com",
+			"jira_password":               "rotated-token",
+			"credential_rotation_trigger": "2026-10",
+		},
+	)
+	d.SetId("b5f3a2c1-8d4e-4f6a-9b7c-0e1d2c3b4a59")
+
+	expected := &wiz.UpdateIntegrationInput{}
+	expected.ID = "b5f3a2c1-8d4e-4f6a-9b7c-0e1d2c3b4a59"
+	expected.Patch.Name = "jira"
+	expected.Patch.Params.Jira = &wiz.UpdateJiraIntegrationParamsInput{}
+	expected.Patch.Params.Jira.ServerURL = "https://example.atlassian.net"
+	expected.Patch.Params.Jira.ServerType = d.Get("jira_server_type").(string)
+	expected.Patch.Params.Jira.IsOnPrem = utils.ConvertBoolToPointer(false)
+	expected.Patch.Params.Jira.TLSConfig.AllowInsecureTLS = utils.ConvertBoolToPointer(false)
+	expected.Patch.Params.Jira.Authorization.Username = "automation@example.com"
+	expected.Patch.Params.Jira.Authorization.Password = "rotated-token"
+
+	vars := getJiraIntegrationUpdateVar(d)
+
+	if !reflect.DeepEqual(vars, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			vars,
+			expected,
+		)
+	}
+}
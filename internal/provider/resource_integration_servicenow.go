@@ -35,10 +35,11 @@ func resourceWizIntegrationServiceNow() *schema.Resource {
 				Computed:    true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The project this action is scoped to.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "The project this action is scoped to.",
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"scope": {
 				Type:     schema.TypeString,
@@ -105,6 +106,11 @@ func resourceWizIntegrationServiceNow() *schema.Resource {
 					nil,
 				),
 			},
+			"credential_rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value, such as a secret version or rotation date. Changing it updates the integration in place and sends the currently configured credentials to Wiz, so rotated secrets are applied without recreating the integration and breaking the automation rules that use it. Only the new credentials are kept in state.",
+			},
 		},
 		CreateContext: resourceWizIntegrationAwsServiceNowCreate,
 		ReadContext:   resourceWizIntegrationAwsServiceNowRead,
@@ -217,7 +223,7 @@ func resourceWizIntegrationAwsServiceNowRead(ctx context.Context, d *schema.Reso
 	data := &ReadIntegrationPayload{}
 	params := &wiz.ServiceNowIntegrationParams{}
 	data.Integration.Params = params
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "integration_servicenow", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "integration_servicenow", func() bool { return data.Integration.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -295,15 +301,7 @@ func resourceWizIntegrationAwsServiceNowUpdate(ctx context.Context, d *schema.Re
 	}`
 
 	// populate the graphql variables
-	vars := &wiz.UpdateIntegrationInput{}
-	vars.ID = d.Id()
-	vars.Patch.Name = d.Get("name").(string)
-	vars.Patch.Params.ServiceNow = &wiz.UpdateServiceNowIntegrationParamsInput{}
-	vars.Patch.Params.ServiceNow.URL = d.Get("servicenow_url").(string)
-	vars.Patch.Params.ServiceNow.Authorization.ClientID = d.Get("servicenow_client_id").(string)
-	vars.Patch.Params.ServiceNow.Authorization.ClientSecret = d.Get("servicenow_client_secret").(string)
-	vars.Patch.Params.ServiceNow.Authorization.Username = d.Get("servicenow_username").(string)
-	vars.Patch.Params.ServiceNow.Authorization.Password = d.Get("servicenow_password").(string)
+	vars := getServiceNowIntegrationUpdateVar(d)
 
 	// process the request
 	data := &UpdateIntegration{}
@@ -315,3 +313,19 @@ func resourceWizIntegrationAwsServiceNowUpdate(ctx context.Context, d *schema.Re
 
 	return diags
 }
+
+// getServiceNowIntegrationUpdateVar returns the update input for the integration, including the configured credentials
+// credentials are sent on every update, which is what applies a rotation when credential_rotation_trigger changes
+func getServiceNowIntegrationUpdateVar(d *schema.ResourceData) *wiz.UpdateIntegrationInput {
+	vars := &wiz.UpdateIntegrationInput{}
+	vars.ID = d.Id()
+	vars.Patch.Name = d.Get("name").(string)
+	vars.Patch.Params.ServiceNow = &wiz.UpdateServiceNowIntegrationParamsInput{}
+	vars.Patch.Params.ServiceNow.URL = d.Get("servicenow_url").(string)
+	vars.Patch.Params.ServiceNow.Authorization.ClientID = d.Get("servicenow_client_id").(string)
+	vars.Patch.Params.ServiceNow.Authorization.ClientSecret = d.Get("servicenow_client_secret").(string)
+	vars.Patch.Params.ServiceNow.Authorization.Username = d.Get("servicenow_username").(string)
+	vars.Patch.Params.ServiceNow.Authorization.Password = d.Get("servicenow_password").(string)
+
+	return vars
+}
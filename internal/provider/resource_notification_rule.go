@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Notification rules send digest and in-app notifications about matching Wiz events to recipients on a channel. Unlike automation rules, they do not trigger actions on integrations.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Wiz internal identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The notification rule name.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether notifications are sent.",
+			},
+			"channel": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: fmt.Sprintf(
+					"The channel notifications are sent on.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.NotificationRuleChannel,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.NotificationRuleChannel,
+						false,
+					),
+				),
+			},
+			"recipients": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The recipients of the notifications, e.g. email addresses for `EMAIL` or channel names for `SLACK` and `TEAMS`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"filters": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "{}",
+				Description: "The events to notify about, as a JSON object of Wiz event filters. Notifies about all events when unset. The value is stored as normalized JSON.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+				StateFunc: func(v interface{}) string {
+					normalized, err := utils.NormalizeJSON(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return normalized
+				},
+			},
+			"last_request_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Wiz request ID of the last successful create or update, to look up the change in the Wiz audit log.",
+			},
+		},
+		CreateContext: resourceWizNotificationRuleCreate,
+		ReadContext:   resourceWizNotificationRuleRead,
+		UpdateContext: resourceWizNotificationRuleUpdate,
+		DeleteContext: resourceWizNotificationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateNotificationRule struct
+type CreateNotificationRule struct {
+	CreateNotificationRule wiz.CreateNotificationRulePayload `json:"createNotificationRule"`
+}
+
+func resourceWizNotificationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizNotificationRuleCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateNotificationRule (
+	    $input: CreateNotificationRuleInput!
+	) {
+	    createNotificationRule(
+	        input: $input
+	    ) {
+	        notificationRule {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateNotificationRuleInput{}
+	vars.Name = d.Get("name").(string)
+	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	vars.Channel = d.Get("channel").(string)
+	vars.Recipients = utils.ConvertListToString(d.Get("recipients").(*schema.Set).List())
+	vars.Filters = json.RawMessage(d.Get("filters").(string))
+
+	// process the request
+	data := &CreateNotificationRule{}
+	requestID, requestDiags := client.ProcessRequestWithRequestID(ctx, m, vars, data, query, "notification_rule", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateNotificationRule.NotificationRule.ID)
+
+	err := d.Set("last_request_id", requestID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return resourceWizNotificationRuleRead(ctx, d, m)
+}
+
+// ReadNotificationRulePayload struct
+type ReadNotificationRulePayload struct {
+	NotificationRule wiz.NotificationRule `json:"notificationRule"`
+}
+
+func resourceWizNotificationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizNotificationRuleRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query notificationRule ($id: ID!){
+	    notificationRule(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        enabled
+	        channel
+	        recipients
+	        filters
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadNotificationRulePayload{}
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "notification_rule", func() bool { return data.NotificationRule.ID != "" })
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.NotificationRule.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.NotificationRule.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.NotificationRule.Enabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("channel", data.NotificationRule.Channel)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("recipients", data.NotificationRule.Recipients)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	filters, err := flattenNotificationRuleFilters(data.NotificationRule.Filters)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("filters", filters)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenNotificationRuleFilters returns the filters as normalized JSON, a rule without filters is returned as an empty object
+func flattenNotificationRuleFilters(filters json.RawMessage) (string, error) {
+	if len(filters) == 0 || string(filters) == "null" {
+		return "{}", nil
+	}
+	return utils.NormalizeJSON(string(filters))
+}
+
+// UpdateNotificationRule struct
+type UpdateNotificationRule struct {
+	UpdateNotificationRule wiz.UpdateNotificationRulePayload `json:"updateNotificationRule"`
+}
+
+func resourceWizNotificationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizNotificationRuleUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateNotificationRule (
+	    $input: UpdateNotificationRuleInput!
+	) {
+	    updateNotificationRule(
+	        input: $input
+	    ) {
+	        notificationRule {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateNotificationRuleInput{}
+	vars.ID = d.Id()
+	if d.HasChange("name") {
+		vars.Patch.Name = d.Get("name").(string)
+	}
+	if d.HasChange("enabled") {
+		vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	}
+	if d.HasChange("channel") {
+		vars.Patch.Channel = d.Get("channel").(string)
+	}
+	if d.HasChange("recipients") {
+		vars.Patch.Recipients = utils.ConvertListToString(d.Get("recipients").(*schema.Set).List())
+	}
+	if d.HasChange("filters") {
+		vars.Patch.Filters = json.RawMessage(d.Get("filters").(string))
+	}
+
+	// process the request
+	data := &UpdateNotificationRule{}
+	requestID, requestDiags := client.ProcessRequestWithRequestID(ctx, m, vars, data, query, "notification_rule", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	err := d.Set("last_request_id", requestID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return resourceWizNotificationRuleRead(ctx, d, m)
+}
+
+// DeleteNotificationRule struct
+type DeleteNotificationRule struct {
+	DeleteNotificationRule wiz.DeleteNotificationRulePayload `json:"deleteNotificationRule"`
+}
+
+func resourceWizNotificationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizNotificationRuleDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteNotificationRule (
+	    $input: DeleteNotificationRuleInput!
+	) {
+	    deleteNotificationRule(
+	        input: $input
+	    ) {
+	        _stub
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteNotificationRuleInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteNotificationRule{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "notification_rule", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
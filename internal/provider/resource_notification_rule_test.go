@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlattenNotificationRuleFilters(t *testing.T) {
+	tests := []struct {
+		filters  json.RawMessage
+		expected string
+	}{
+		{nil, "{}"},
+		{json.RawMessage("null"), "{}"},
+		{json.RawMessage(`{"severity": ["CRITICAL", "HIGH"],  "eventType":"ISSUE_CREATED"}`), `{"eventType":"ISSUE_CREATED","severity":["CRITICAL","HIGH"]}`},
+	}
+
+	for _, tc := range tests {
+		filters, err := flattenNotificationRuleFilters(tc.filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if filters != tc.expected {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", filters, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizOutpost() *schema.Resource {
+	return &schema.Resource{
+		Description: "Outposts run Wiz scanning in a customer-hosted environment, so workloads can be scanned without data leaving the customer account. Outposts are distinct from connectors, which grant Wiz access to a cloud account.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Wiz internal identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The outpost name.",
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: fmt.Sprintf(
+					"The cloud the outpost is deployed to (changing this requires re-creating the outpost).\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.OutpostServiceType,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.OutpostServiceType,
+						false,
+					),
+				),
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The outpost configuration for the service type, as a JSON object. The value is stored as normalized JSON.",
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringIsJSON,
+				),
+				StateFunc: func(v interface{}) string {
+					normalized, err := utils.NormalizeJSON(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return normalized
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the outpost is used for scanning.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The deployment status of the outpost reported by Wiz.",
+			},
+		},
+		CreateContext: resourceWizOutpostCreate,
+		ReadContext:   resourceWizOutpostRead,
+		UpdateContext: resourceWizOutpostUpdate,
+		DeleteContext: resourceWizOutpostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateOutpost struct
+type CreateOutpost struct {
+	CreateOutpost wiz.CreateOutpostPayload `json:"createOutpost"`
+}
+
+func resourceWizOutpostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostCreate called...")
+
+	// define the graphql query
+	query := `mutation CreateOutpost (
+	    $input: CreateOutpostInput!
+	) {
+	    createOutpost(
+	        input: $input
+	    ) {
+	        outpost {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateOutpostInput{}
+	vars.Name = d.Get("name").(string)
+	vars.ServiceType = d.Get("service_type").(string)
+	vars.Config = json.RawMessage(d.Get("config").(string))
+	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+
+	// process the request
+	data := &CreateOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "create")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(data.CreateOutpost.Outpost.ID)
+
+	return resourceWizOutpostRead(ctx, d, m)
+}
+
+// ReadOutpostPayload struct
+type ReadOutpostPayload struct {
+	Outpost wiz.Outpost `json:"outpost"`
+}
+
+func resourceWizOutpostRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query outpost ($id: ID!){
+	    outpost(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        serviceType
+	        config
+	        enabled
+	        status
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadOutpostPayload{}
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "outpost", func() bool { return data.Outpost.ID != "" })
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Outpost.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("name", data.Outpost.Name)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("service_type", data.Outpost.ServiceType)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	outpostConfig, err := utils.NormalizeJSON(string(data.Outpost.Config))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("config", outpostConfig)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("enabled", data.Outpost.Enabled)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("status", data.Outpost.Status)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// UpdateOutpost struct
+type UpdateOutpost struct {
+	UpdateOutpost wiz.UpdateOutpostPayload `json:"updateOutpost"`
+}
+
+func resourceWizOutpostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation UpdateOutpost (
+	    $input: UpdateOutpostInput!
+	) {
+	    updateOutpost(
+	        input: $input
+	    ) {
+	        outpost {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateOutpostInput{}
+	vars.ID = d.Id()
+	if d.HasChange("name") {
+		vars.Patch.Name = d.Get("name").(string)
+	}
+	if d.HasChange("config") {
+		vars.Patch.Config = json.RawMessage(d.Get("config").(string))
+	}
+	if d.HasChange("enabled") {
+		vars.Patch.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
+	}
+
+	// process the request
+	data := &UpdateOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizOutpostRead(ctx, d, m)
+}
+
+// DeleteOutpost struct
+type DeleteOutpost struct {
+	DeleteOutpost wiz.DeleteOutpostPayload `json:"deleteOutpost"`
+}
+
+func resourceWizOutpostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizOutpostDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `mutation DeleteOutpost (
+	    $input: DeleteOutpostInput!
+	) {
+	    deleteOutpost(
+	        input: $input
+	    ) {
+	        _stub
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteOutpostInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteOutpost{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "outpost", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
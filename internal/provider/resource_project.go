@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -14,6 +16,7 @@ import (
 
 	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
@@ -46,6 +49,29 @@ func resourceWizProject() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"delete_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "archive",
+				Description: fmt.Sprintf(
+					"How the project is removed from Wiz when the resource is destroyed. `archive` marks the project as archived (and renames it to its slug so the name can be reused), `delete` permanently deletes the project.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ProjectDeleteBehavior,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ProjectDeleteBehavior,
+						false,
+					),
+				),
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the project even when SAML group mappings grant access to it. By default, destroying a project that is referenced by a `wiz_saml_idp` group mapping fails with an error listing the mappings, since removing it can take access away from every member of the mapped groups.",
+			},
 			"is_folder": {
 				Type:        schema.TypeBool,
 				Description: "Whether the project is a folder.",
@@ -58,9 +84,10 @@ func resourceWizProject() *schema.Resource {
 				Optional:    true,
 			},
 			"parent_project_id": {
-				Type:        schema.TypeString,
-				Description: "The parent project ID.",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "The parent project ID. A project cannot be its own parent, and with `validate_on_plan` enabled a parent that is a descendant of the project is rejected at plan time.",
+				Optional:         true,
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"project_owners": {
 				Type:        schema.TypeList,
@@ -471,6 +498,7 @@ func resourceWizProject() *schema.Resource {
 				}
 				return nil
 			},
+			validateProjectParentOnPlan,
 		),
 		CreateContext: resourceWizProjectCreate,
 		ReadContext:   resourceWizProjectRead,
@@ -640,6 +668,7 @@ func resourceWizProjectCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	// set the id
 	d.SetId(data.CreateProject.Project.ID)
+	forgetProjectSlugs(m, d.Id(), vars.Slug)
 
 	return resourceWizProjectRead(ctx, d, m)
 }
@@ -762,6 +791,75 @@ func flattenUserIds(ctx context.Context, userIds []*wiz.User) []interface{} {
 	return output
 }
 
+// validateProjectParentOnPlan rejects a parent project that would create a cycle in the project hierarchy
+// self references are always rejected, descendants are only detected when validate_on_plan is enabled
+func validateProjectParentOnPlan(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("parent_project_id") {
+		return nil
+	}
+	parentProjectID := diff.Get("parent_project_id").(string)
+	if parentProjectID == "" {
+		return nil
+	}
+	if parentProjectID == diff.Id() {
+		return fmt.Errorf("project %s cannot be its own parent", diff.Id())
+	}
+
+	conf, ok := m.(*config.ProviderConf)
+	if !ok || conf.Settings == nil || !conf.Settings.ValidateOnPlan {
+		return nil
+	}
+	ancestors, diags := readProjectAncestors(ctx, m, parentProjectID)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read the ancestors of parent project %s: %s", parentProjectID, diags[0].Summary)
+	}
+	return projectParentCycleError(diff.Id(), parentProjectID, ancestors)
+}
+
+// readProjectAncestors returns the IDs of the ancestors of a project
+func readProjectAncestors(ctx context.Context, m interface{}, projectID string) (ancestors []string, diags diag.Diagnostics) {
+	tflog.Info(ctx, "readProjectAncestors called...")
+
+	// define the graphql query
+	query := `query project ($id: ID!){
+	    project(
+	        id: $id
+	    ) {
+	        id
+	        ancestorProjects {
+	            id
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = projectID
+
+	// process the request
+	data := &ReadProjectPayload{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "project", "read")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return nil, diags
+	}
+
+	for _, b := range data.Project.AncestorProjects {
+		ancestors = append(ancestors, b.ID)
+	}
+	return ancestors, diags
+}
+
+// projectParentCycleError returns an error when the project is an ancestor of its new parent
+func projectParentCycleError(projectID string, parentProjectID string, parentAncestors []string) error {
+	for _, id := range parentAncestors {
+		if id == projectID {
+			return fmt.Errorf("project %s cannot be moved under project %s, which is one of its descendants", projectID, parentProjectID)
+		}
+	}
+	return nil
+}
+
 // ReadProjectPayload struct -- updates
 type ReadProjectPayload struct {
 	Project wiz.Project `json:"project"`
@@ -861,9 +959,17 @@ func resourceWizProjectRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	// process the request
 	data := &ReadProjectPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "project", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "project", func() bool { return data.Project.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.Project.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
 		return diags
 	}
 
@@ -877,11 +983,14 @@ func resourceWizProjectRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	// the parent project will be the first element of the list of ancestor projects
+	// a project without ancestors has no parent, so a parent removed outside Terraform is reported as drift
+	parentProjectID := ""
 	if len(data.Project.AncestorProjects) > 0 {
-		err = d.Set("parent_project_id", data.Project.AncestorProjects[0].ID)
-		if err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
+		parentProjectID = data.Project.AncestorProjects[0].ID
+	}
+	err = d.Set("parent_project_id", parentProjectID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	err = d.Set("description", data.Project.Description)
@@ -892,6 +1001,19 @@ func resourceWizProjectRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	// delete_behavior and force_delete are provider-side only, so default them for imported projects
+	if _, ok := d.GetOk("delete_behavior"); !ok {
+		err = d.Set("delete_behavior", "archive")
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+	if _, ok := d.GetOk("force_delete"); !ok {
+		err = d.Set("force_delete", false)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
 	err = d.Set("slug", data.Project.Slug)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -1085,6 +1207,7 @@ func resourceWizProjectUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if len(diags) > 0 {
 		return diags
 	}
+	forgetProjectSlugs(m, d.Id(), vars.Override.Slug)
 
 	return resourceWizProjectRead(ctx, d, m)
 }
@@ -1103,6 +1226,18 @@ func resourceWizProjectDelete(ctx context.Context, d *schema.ResourceData, m int
 		return nil
 	}
 
+	// refuse to remove a project that group mappings still grant access to
+	if !d.Get("force_delete").(bool) {
+		dependentDiags := projectDependentDiags(ctx, m, d.Id())
+		if dependentDiags.HasError() {
+			return append(diags, dependentDiags...)
+		}
+	}
+
+	if d.Get("delete_behavior").(string) == "delete" {
+		return deleteProject(ctx, d, m)
+	}
+
 	// define the graphql query
 	query := `mutation UpdateProject($input: UpdateProjectInput!) {
           updateProject(input: $input) {
@@ -1127,6 +1262,194 @@ func resourceWizProjectDelete(ctx context.Context, d *schema.ResourceData, m int
 	if len(diags) > 0 {
 		return diags
 	}
+	forgetProjectSlugs(m, d.Id())
+
+	return diags
+}
+
+// projectDependentDiags returns an error listing the SAML group mappings that reference the project
+func projectDependentDiags(ctx context.Context, m interface{}, projectID string) diag.Diagnostics {
+	tflog.Info(ctx, "projectDependentDiags called...")
+
+	identityProviders, diags := readSAMLIdentityProviders(ctx, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	references := projectGroupMappingReferences(identityProviders, projectID)
+	if len(references) == 0 {
+		return diags
+	}
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Project is referenced by SAML group mappings",
+		Detail:   fmt.Sprintf("Removing project %s takes access away from the members of these groups:\n  - %s\n\nRemove the project from the group mappings first, or set force_delete to remove it anyway.", projectID, strings.Join(references, "\n  - ")),
+	})
+}
+
+// projectGroupMappingReferences describes the group mappings that grant access to the project, sorted
+func projectGroupMappingReferences(identityProviders []*wiz.SAMLIdentityProvider, projectID string) []string {
+	var references []string
+	for _, idp := range identityProviders {
+		for _, mapping := range idp.GroupMapping {
+			for _, project := range mapping.Projects {
+				if project.ID == projectID {
+					references = append(references, fmt.Sprintf("%s: group %s (role %s)", idp.Name, mapping.ProviderGroupID, mapping.Role.ID))
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(references)
+	return references
+}
+
+// DeleteProject struct
+type DeleteProject struct {
+	DeleteProject wiz.DeleteProjectPayload `json:"deleteProject"`
+}
+
+// deleteProject permanently deletes the project rather than archiving it
+func deleteProject(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "deleteProject called...")
+
+	// define the graphql query
+	query := `mutation DeleteProject($input: DeleteProjectInput!) {
+          deleteProject(input: $input) {
+            _stub
+          }
+        }`
+
+	// populate the graphql variables
+	vars := &wiz.DeleteProjectInput{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &DeleteProject{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "project", "delete")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	forgetProjectSlugs(m, d.Id())
 
 	return diags
 }
+
+// projectSlugPrefix identifies project references that are expressed as a slug rather than an ID
+const projectSlugPrefix = "slug:"
+
+// ReadProjects struct
+type ReadProjects struct {
+	Projects wiz.ProjectConnection `json:"projects"`
+}
+
+// resolveProjectSlugs maps each `slug:` prefixed project reference to the matching project ID
+// references that are not prefixed are treated as project IDs and returned unchanged
+// only the requested slugs are read, and resolutions are cached on the provider until wiz_project changes the project
+func resolveProjectSlugs(ctx context.Context, m interface{}, projects []string) (resolved []string, diags diag.Diagnostics) {
+	tflog.Info(ctx, "resolveProjectSlugs called...")
+
+	conf := m.(*config.ProviderConf)
+
+	// collect the slugs that have not been resolved yet
+	var pending []string
+	for _, p := range projects {
+		if !strings.HasPrefix(p, projectSlugPrefix) {
+			continue
+		}
+		slug := strings.TrimPrefix(p, projectSlugPrefix)
+		if _, ok := conf.ProjectSlugs.Load(slug); !ok {
+			pending = append(pending, slug)
+		}
+	}
+
+	if len(pending) > 0 {
+		// define the graphql query
+		query := `query projects (
+		    $first: Int
+		    $after: String
+		    $filterBy: ProjectFilters
+		) {
+		    projects(
+		        first: $first
+		        after: $after
+		        filterBy: $filterBy
+		    ) {
+		        nodes {
+		            id
+		            slug
+		        }
+		        pageInfo {
+		            endCursor
+		            hasNextPage
+		        }
+		        totalCount
+		    }
+		}`
+
+		// populate the graphql variables
+		vars := &internal.QueryVariables{}
+		vars.First = 500
+		vars.FilterBy = &wiz.ProjectFilters{
+			Slug: utils.Unique(pending),
+		}
+
+		// process the request
+		data := &ReadProjects{}
+		requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "project", "read", 0)
+		diags = append(diags, requestDiags...)
+		if len(diags) > 0 {
+			return nil, diags
+		}
+
+		for _, a := range allData {
+			for _, b := range a.(*ReadProjects).Projects.Nodes {
+				if slices.Contains(pending, b.Slug) {
+					conf.ProjectSlugs.Store(b.Slug, b.ID)
+				}
+			}
+		}
+	}
+
+	// substitute the slugs with project ids
+	var unresolved []string
+	for _, p := range projects {
+		if !strings.HasPrefix(p, projectSlugPrefix) {
+			resolved = append(resolved, p)
+			continue
+		}
+		slug := strings.TrimPrefix(p, projectSlugPrefix)
+		id, ok := conf.ProjectSlugs.Load(slug)
+		if !ok {
+			unresolved = append(unresolved, slug)
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("resolved project slug %s to %s", slug, id.(string)))
+		resolved = append(resolved, id.(string))
+	}
+	if len(unresolved) > 0 {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to resolve project slug",
+			Detail:   fmt.Sprintf("No project was found with slug: %s", strings.Join(unresolved, ", ")),
+		})
+	}
+
+	return resolved, diags
+}
+
+// forgetProjectSlugs removes the cached slug resolutions of a project and of slugs, so they are read again
+// it is called when a project is created, updated or removed, since its slug may have changed or been reused
+func forgetProjectSlugs(m interface{}, projectID string, slugs ...string) {
+	conf := m.(*config.ProviderConf)
+	for _, slug := range slugs {
+		conf.ProjectSlugs.Delete(slug)
+	}
+	conf.ProjectSlugs.Range(func(slug, id interface{}) bool {
+		if id.(string) == projectID {
+			conf.ProjectSlugs.Delete(slug)
+		}
+		return true
+	})
+}
@@ -56,17 +56,19 @@ func resourceWizProjectCloudAccountLink() *schema.Resource {
 				Computed:    true,
 			},
 			"project_id": {
-				Type:        schema.TypeString,
-				Description: "The Wiz internal identifier of the Wiz project to link the cloud account to",
-				Required:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "The Wiz internal identifier of the Wiz project to link the cloud account to",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"cloud_account_id": {
-				Type:        schema.TypeString,
-				Description: "The Wiz internal identifier for the Cloud Account Subscription.",
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Description:      "The Wiz internal identifier for the Cloud Account Subscription.",
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: utils.ValidateUUID,
 			},
 			"external_cloud_account_id": {
 				Type:        schema.TypeString,
@@ -294,7 +296,10 @@ func resourceWizProjectCloudAccountLinkCreate(ctx context.Context, d *schema.Res
 	}
 
 	if linkExists {
-		return diag.Errorf("cloud account %s is already linked to project %s", cloudAccountWizID, projectID)
+		return append(diags, utils.AlreadyExistsDiagnostic(
+			fmt.Sprintf("cloud account %s is already linked to project %s", cloudAccountWizID, projectID),
+			fmt.Sprintf("link|%s|%s", projectID, cloudAccountWizID),
+		))
 	}
 
 	// link not present, add it to the project
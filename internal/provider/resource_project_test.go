@@ -1,13 +1,18 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
 	"reflect"
 	"sort"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
@@ -535,3 +540,127 @@ func TestGetOrganizationLinksVar(t *testing.T) {
 		)
 	}
 }
+
+func TestProjectParentCycleError(t *testing.T) {
+	projectID := "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+
+	// moving under an unrelated project is allowed
+	err := projectParentCycleError(projectID, "9e8d7c6b-5a49-4382-9716-0f1e2d3c4b5a", []string{
+		"0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	// moving under a descendant creates a cycle
+	err = projectParentCycleError(projectID, "9e8d7c6b-5a49-4382-9716-0f1e2d3c4b5a", []string{
+		projectID,
+		"0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error when the new parent is a descendant of the project")
+	}
+}
+
+func TestProjectGroupMappingReferences(t *testing.T) {
+	projectID := "ee2e4a4d-8f4e-4c34-8a35-1b1c6c1d2e3f"
+	identityProviders := []*wiz.SAMLIdentityProvider{
+		{
+			Name: "okta",
+			GroupMapping: []*wiz.SAMLGroupMapping{
+				{
+					ProviderGroupID: "platform",
+					Role:            wiz.UserRole{ID: "PROJECT_ADMIN"},
+					Projects:        []wiz.Project{{ID: "5b7d9f1a-3c5e-4a7b-9d1f-4a6c8e0b2d04"}, {ID: projectID}},
+				},
+				{
+					ProviderGroupID: "security",
+					Role:            wiz.UserRole{ID: "GLOBAL_READER"},
+				},
+			},
+		},
+		{
+			Name: "azure-ad",
+			GroupMapping: []*wiz.SAMLGroupMapping{
+				{
+					ProviderGroupID: "developers",
+					Role:            wiz.UserRole{ID: "PROJECT_READER"},
+					Projects:        []wiz.Project{{ID: projectID}},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"azure-ad: group developers (role PROJECT_READER)",
+		"okta: group platform (role PROJECT_ADMIN)",
+	}
+	references := projectGroupMappingReferences(identityProviders, projectID)
+	if !reflect.DeepEqual(references, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			references,
+			expected,
+		)
+	}
+
+	references = projectGroupMappingReferences(identityProviders, "c9e1a3b5-7d9f-4b1c-8e2a-5f7b9d1e3a05")
+	if len(references) != 0 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			references,
+			[]string{},
+		)
+	}
+}
+
+func TestResolveProjectSlugs(t *testing.T) {
+	ctx := context.Background()
+
+	// only the requested slugs are read
+	var requested [][]string
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				var body struct {
+					Variables struct {
+						FilterBy struct {
+							Slug []string `json:"slug"`
+						} `json:"filterBy"`
+					} `json:"variables"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				requested = append(requested, body.Variables.FilterBy.Slug)
+				response := `{"data":{"projects":{"nodes":[{"id":"e228be4f-1697-4c02-ab8c-7d3c526cb22c","slug":"my-project"}],"pageInfo":{"hasNextPage":false}}}}`
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(response)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+	}
+
+	resolved, diags := resolveProjectSlugs(ctx, m, []string{"slug:my-project", "aad6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e11"})
+	if diags.HasError() {
+		t.Fatalf("Got:\n\n%#v\n\nExpected no errors\n", diags)
+	}
+	expected := []string{"e228be4f-1697-4c02-ab8c-7d3c526cb22c", "aad6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e11"}
+	if !reflect.DeepEqual(resolved, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", resolved, expected)
+	}
+
+	// the resolution is cached until the project changes
+	_, _ = resolveProjectSlugs(ctx, m, []string{"slug:my-project"})
+	forgetProjectSlugs(m, "e228be4f-1697-4c02-ab8c-7d3c526cb22c")
+	_, _ = resolveProjectSlugs(ctx, m, []string{"slug:my-project"})
+	expectedRequests := [][]string{{"my-project"}, {"my-project"}}
+	if !reflect.DeepEqual(requested, expectedRequests) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", requested, expectedRequests)
+	}
+}
@@ -0,0 +1,656 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+// projectsReadBatchSize is the number of projects read per request by wiz_projects
+const projectsReadBatchSize = 50
+
+// projectNotFoundErrorCode is the error code returned by the api when reading a project that does not exist
+const projectNotFoundErrorCode = "NOT_FOUND"
+
+func resourceWizProjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage a set of similar projects with a single resource, e.g. to stand up an environment. Projects are read back in batches of 50 per request; Wiz has no bulk mutations, so they are created, updated and removed one request at a time. Failures are reported together after the other projects are processed. When an update fails for some projects, the projects that succeeded are kept in state, so applying again only retries the failed ones. When a create fails for some projects, the projects that were created are deleted again and the apply fails, so applying again creates all of them without replacing the resource. Use `wiz_project` for projects with cloud account links, owners or a risk profile.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier generated by the provider.",
+			},
+			"delete_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "archive",
+				Description: fmt.Sprintf(
+					"How projects are removed from Wiz when they are removed from `project` or the resource is destroyed. `archive` marks the project as archived (and renames it to its slug so the name can be reused), `delete` permanently deletes the project.\n    - Allowed values: %s",
+					utils.SliceOfStringToMDUList(
+						wiz.ProjectDeleteBehavior,
+					),
+				),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice(
+						wiz.ProjectDeleteBehavior,
+						false,
+					),
+				),
+			},
+			"project": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The projects to manage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Identifies the project within this resource, and must be unique. Changing the key replaces the project, the other attributes are updated in place.",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The project name to display in Wiz.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The project description.",
+						},
+						"business_unit": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The business unit to which the project belongs.",
+						},
+						"parent_project_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "The parent project ID.",
+							ValidateDiagFunc: utils.ValidateUUID,
+						},
+					},
+				},
+			},
+			"project_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The identifiers of the projects, keyed by `key`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CreateContext: resourceWizProjectsCreate,
+		ReadContext:   resourceWizProjectsRead,
+		UpdateContext: resourceWizProjectsUpdate,
+		DeleteContext: resourceWizProjectsDelete,
+		CustomizeDiff: validateProjectKeysOnPlan,
+	}
+}
+
+// projectDefinition is a project block of wiz_projects
+type projectDefinition struct {
+	Key             string
+	Name            string
+	Description     string
+	BusinessUnit    string
+	ParentProjectID string
+}
+
+// expandProjectDefinitions returns the project blocks of wiz_projects
+func expandProjectDefinitions(projects []interface{}) []projectDefinition {
+	var output []projectDefinition
+	for _, a := range projects {
+		b, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		output = append(output, projectDefinition{
+			Key:             b["key"].(string),
+			Name:            b["name"].(string),
+			Description:     b["description"].(string),
+			BusinessUnit:    b["business_unit"].(string),
+			ParentProjectID: b["parent_project_id"].(string),
+		})
+	}
+	return output
+}
+
+// duplicateProjectKeys returns the keys used by more than one project block
+func duplicateProjectKeys(projects []projectDefinition) []string {
+	seen := make(map[string]bool)
+	var duplicates []string
+	for _, a := range projects {
+		if seen[a.Key] {
+			duplicates = append(duplicates, a.Key)
+		}
+		seen[a.Key] = true
+	}
+	return utils.Unique(duplicates)
+}
+
+// validateProjectKeysOnPlan rejects project blocks that share a key
+func validateProjectKeysOnPlan(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	duplicates := duplicateProjectKeys(expandProjectDefinitions(diff.Get("project").([]interface{})))
+	if len(duplicates) > 0 {
+		return fmt.Errorf("project keys must be unique, found duplicates: %s", strings.Join(duplicates, ", "))
+	}
+	return nil
+}
+
+// projectChanges lists the changes that reconcile wiz_projects with its configuration
+type projectChanges struct {
+	Create []projectDefinition
+	Update []projectDefinition
+	Remove []string
+}
+
+// diffProjectDefinitions compares the desired projects with the previous definitions and the projects known to exist
+// projects without an identifier are created, whether they failed to create before or were removed outside Terraform
+func diffProjectDefinitions(desired []projectDefinition, previous []projectDefinition, projectIDs map[string]interface{}) projectChanges {
+	var changes projectChanges
+
+	previousByKey := make(map[string]projectDefinition)
+	for _, a := range previous {
+		previousByKey[a.Key] = a
+	}
+	desiredKeys := make(map[string]bool)
+	for _, a := range desired {
+		desiredKeys[a.Key] = true
+		if _, ok := projectIDs[a.Key]; !ok {
+			changes.Create = append(changes.Create, a)
+			continue
+		}
+		if p, ok := previousByKey[a.Key]; !ok || p != a {
+			changes.Update = append(changes.Update, a)
+		}
+	}
+	for key := range projectIDs {
+		if !desiredKeys[key] {
+			changes.Remove = append(changes.Remove, key)
+		}
+	}
+	sort.Strings(changes.Remove)
+
+	return changes
+}
+
+// projectDiagnostics prefixes the errors of a project change with the project key, so aggregated failures can be told apart
+func projectDiagnostics(key string, diags diag.Diagnostics) diag.Diagnostics {
+	for i := range diags {
+		diags[i].Summary = fmt.Sprintf("project %s: %s", key, diags[i].Summary)
+	}
+	return diags
+}
+
+func resourceWizProjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizProjectsCreate called...")
+
+	// set the id
+	d.SetId(id.UniqueId())
+
+	diags = reconcileProjects(ctx, d, m)
+
+	if !diags.HasError() {
+		return resourceWizProjectsRead(ctx, d, m)
+	}
+
+	// an error with the id set would taint the resource and replace the projects that were created,
+	// so they are deleted again and the create fails as a whole, the next apply creates every project
+	return append(diags, rollbackProjectsCreate(ctx, d, m)...)
+}
+
+// rollbackProjectsCreate deletes the projects created by a create that failed and clears the id
+// projects that cannot be deleted are kept in state, so the tainted resource removes them when it is replaced
+func rollbackProjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "rollbackProjectsCreate called...")
+
+	projectIDs := make(map[string]interface{})
+	var keys []string
+	for k, v := range d.Get("project_ids").(map[string]interface{}) {
+		projectIDs[k] = v
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// the projects were created by this apply and nothing references them yet, so they are deleted rather than archived
+	diags = removeProjects(ctx, m, keys, projectIDs, "delete")
+	if len(projectIDs) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	err := d.Set("project_ids", projectIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// reconcileProjects creates, updates and removes projects until they match the configuration
+// every change is attempted, the errors are returned together and project_ids records the projects that exist
+func reconcileProjects(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "reconcileProjects called...")
+
+	projectIDs := make(map[string]interface{})
+	for k, v := range d.Get("project_ids").(map[string]interface{}) {
+		projectIDs[k] = v
+	}
+	previous, desired := d.GetChange("project")
+	changes := diffProjectDefinitions(
+		expandProjectDefinitions(desired.([]interface{})),
+		expandProjectDefinitions(previous.([]interface{})),
+		projectIDs,
+	)
+	tflog.Debug(ctx, fmt.Sprintf("Project changes: %s", utils.PrettyPrint(changes)))
+
+	for _, a := range changes.Create {
+		projectID, createDiags := createProjectDefinition(ctx, m, a)
+		diags = append(diags, projectDiagnostics(a.Key, createDiags)...)
+		if projectID != "" {
+			projectIDs[a.Key] = projectID
+		}
+	}
+	for _, a := range changes.Update {
+		diags = append(diags, projectDiagnostics(a.Key, updateProjectDefinition(ctx, m, projectIDs[a.Key].(string), a))...)
+	}
+	diags = append(diags, removeProjects(ctx, m, changes.Remove, projectIDs, d.Get("delete_behavior").(string))...)
+
+	err := d.Set("project_ids", projectIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// createProjectDefinition creates a project and returns its identifier
+func createProjectDefinition(ctx context.Context, m interface{}, project projectDefinition) (string, diag.Diagnostics) {
+	tflog.Info(ctx, "createProjectDefinition called...")
+
+	// define the graphql query
+	query := `mutation CreateProject($input: CreateProjectInput!) {
+	  createProject(input: $input) {
+	    project {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.CreateProjectInput{}
+	vars.Name = project.Name
+	vars.Description = project.Description
+	vars.BusinessUnit = project.BusinessUnit
+	vars.ParentProjectID = project.ParentProjectID
+	vars.Slug = uuid.New().String()
+
+	// process the request
+	data := &CreateProject{}
+	diags := client.ProcessRequest(ctx, m, vars, data, query, "projects", "create")
+	if len(diags) > 0 {
+		return "", diags
+	}
+
+	return data.CreateProject.Project.ID, diags
+}
+
+// updateProjectDefinition sets the attributes managed by wiz_projects, links and owners are left unchanged
+func updateProjectDefinition(ctx context.Context, m interface{}, projectID string, project projectDefinition) diag.Diagnostics {
+	tflog.Info(ctx, "updateProjectDefinition called...")
+
+	// define the graphql query
+	query := `mutation UpdateProject($input: UpdateProjectInput!) {
+	  updateProject(input: $input) {
+	    project {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateProjectAttributes{}
+	vars.ID = projectID
+	vars.Patch.Name = project.Name
+	vars.Patch.Description = &project.Description
+	vars.Patch.BusinessUnit = &project.BusinessUnit
+	vars.Patch.ParentProjectID = &project.ParentProjectID
+
+	// process the request
+	data := &UpdateProject{}
+	return client.ProcessRequest(ctx, m, vars, data, query, "projects", "update")
+}
+
+// removeProjects archives or deletes the projects of keys and removes them from projectIDs
+func removeProjects(ctx context.Context, m interface{}, keys []string, projectIDs map[string]interface{}, deleteBehavior string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "removeProjects called...")
+
+	if len(keys) == 0 {
+		return diags
+	}
+
+	// archived projects are renamed to their slug, so the slugs are read first
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, projectIDs[key].(string))
+	}
+	projects, readDiags := readProjectsByID(ctx, m, ids)
+	diags = append(diags, readDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, key := range keys {
+		project := projects[projectIDs[key].(string)]
+		if project == nil || project.Archived {
+			tflog.Debug(ctx, fmt.Sprintf("Project %s was already removed", key))
+			delete(projectIDs, key)
+			continue
+		}
+		removeDiags := removeProject(ctx, m, project, deleteBehavior)
+		diags = append(diags, projectDiagnostics(key, removeDiags)...)
+		if !removeDiags.HasError() {
+			delete(projectIDs, key)
+		}
+	}
+
+	return diags
+}
+
+// removeProject archives or deletes a project
+func removeProject(ctx context.Context, m interface{}, project *wiz.Project, deleteBehavior string) diag.Diagnostics {
+	tflog.Info(ctx, "removeProject called...")
+
+	if deleteBehavior == "delete" {
+		// define the graphql query
+		query := `mutation DeleteProject($input: DeleteProjectInput!) {
+	      deleteProject(input: $input) {
+	        _stub
+	      }
+	    }`
+
+		// populate the graphql variables
+		vars := &wiz.DeleteProjectInput{}
+		vars.ID = project.ID
+
+		// process the request
+		data := &DeleteProject{}
+		diags := client.ProcessRequest(ctx, m, vars, data, query, "projects", "delete")
+		if len(diags) == 0 {
+			forgetProjectSlugs(m, project.ID)
+		}
+		return diags
+	}
+
+	// define the graphql query
+	query := `mutation UpdateProject($input: UpdateProjectInput!) {
+	  updateProject(input: $input) {
+	    project {
+	      id
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	vars := &wiz.UpdateProjectAttributes{}
+	vars.ID = project.ID
+	vars.Patch.Name = project.Slug
+	vars.Patch.Slug = project.Slug
+	vars.Patch.Archived = utils.ConvertBoolToPointer(true)
+
+	// process the request
+	data := &UpdateProject{}
+	diags := client.ProcessRequest(ctx, m, vars, data, query, "projects", "delete")
+	if len(diags) == 0 {
+		forgetProjectSlugs(m, project.ID)
+	}
+	return diags
+}
+
+// readProjectsByID returns the projects with the given identifiers, keyed by identifier
+// projects are read in batches using aliases, projects that do not exist are left out
+func readProjectsByID(ctx context.Context, m interface{}, ids []string) (map[string]*wiz.Project, diag.Diagnostics) {
+	tflog.Info(ctx, "readProjectsByID called...")
+
+	var diags diag.Diagnostics
+	projects := make(map[string]*wiz.Project)
+	for start := 0; start < len(ids); start += projectsReadBatchSize {
+		end := start + projectsReadBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+
+		// define the graphql query, one aliased field per project
+		var params, fields []string
+		vars := make(map[string]string)
+		for i, projectID := range batch {
+			params = append(params, fmt.Sprintf("$id%d: ID", i))
+			fields = append(fields, fmt.Sprintf("p%d: project(id: $id%d) { id name slug description businessUnit archived }", i, i))
+			vars[fmt.Sprintf("id%d", i)] = projectID
+		}
+		query := fmt.Sprintf("query projects (%s) {\n%s\n}", strings.Join(params, ", "), strings.Join(fields, "\n"))
+
+		// process the request
+		data := make(map[string]*wiz.Project)
+		requestDiags := client.ProcessRequest(ctx, m, vars, &data, query, "projects", "read")
+		if len(requestDiags) > 0 && !onlyErrorCode(requestDiags, projectNotFoundErrorCode) {
+			// the errors cannot be matched to the projects of the batch, so the projects are read individually
+			tflog.Debug(ctx, "Batched project read reported errors, reading projects individually")
+			for _, projectID := range batch {
+				project, projectDiags := readProjectByID(ctx, m, projectID)
+				diags = append(diags, projectDiags...)
+				if project != nil {
+					projects[project.ID] = project
+				}
+			}
+			continue
+		}
+		// projects that do not exist are null in the response, the other projects of the batch are returned
+		for _, project := range data {
+			if project != nil && project.ID != "" {
+				projects[project.ID] = project
+			}
+		}
+	}
+
+	return projects, diags
+}
+
+// onlyErrorCode reports whether every diagnostic is an api error with the given code
+// the data of a request is still decoded when the api reports errors, so the fields without errors can be used
+func onlyErrorCode(diags diag.Diagnostics, code string) bool {
+	for _, e := range diags {
+		codes := client.ErrorCodes(e)
+		if e.Severity != diag.Error || len(codes) == 0 {
+			return false
+		}
+		for _, c := range codes {
+			if c != code {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// readProjectByID returns a project, or nil when it does not exist
+func readProjectByID(ctx context.Context, m interface{}, projectID string) (*wiz.Project, diag.Diagnostics) {
+	tflog.Info(ctx, "readProjectByID called...")
+
+	// define the graphql query
+	query := `query project ($id: ID){
+	    project(
+	        id: $id
+	    ) {
+	        id
+	        name
+	        slug
+	        description
+	        businessUnit
+	        archived
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = projectID
+
+	// process the request
+	data := &ReadProjectPayload{}
+	diags := client.ProcessRequest(ctx, m, vars, data, query, "projects", "read")
+	for _, e := range diags {
+		if e.Severity == diag.Error && slices.Contains(client.ErrorCodes(e), projectNotFoundErrorCode) {
+			tflog.Info(ctx, fmt.Sprintf("Project %s not found", projectID))
+			return nil, nil
+		}
+	}
+	if len(diags) > 0 {
+		return nil, diags
+	}
+	if data.Project.ID == "" {
+		return nil, diags
+	}
+
+	return &data.Project, diags
+}
+
+func resourceWizProjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizProjectsRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	projectIDs := d.Get("project_ids").(map[string]interface{})
+	ids := make([]string, 0, len(projectIDs))
+	for _, v := range projectIDs {
+		ids = append(ids, v.(string))
+	}
+	sort.Strings(ids)
+
+	// process the request
+	projects, requestDiags := readProjectsByID(ctx, m, ids)
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	definitions, existingIDs := flattenProjectDefinitions(expandProjectDefinitions(d.Get("project").([]interface{})), projectIDs, projects)
+
+	// set the resource parameters
+	err := d.Set("project", definitions)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("project_ids", existingIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenProjectDefinitions returns the project blocks and identifiers of the projects that exist in Wiz
+// projects that were removed or archived outside Terraform are left out, so the next apply creates them again
+// the parent project is not read back and is kept as configured
+func flattenProjectDefinitions(current []projectDefinition, projectIDs map[string]interface{}, projects map[string]*wiz.Project) ([]interface{}, map[string]interface{}) {
+	definitions := make([]interface{}, 0, len(projectIDs))
+	existingIDs := make(map[string]interface{})
+
+	flatten := func(a projectDefinition) {
+		projectID, ok := projectIDs[a.Key].(string)
+		if !ok {
+			return
+		}
+		project := projects[projectID]
+		if project == nil || project.Archived {
+			return
+		}
+		existingIDs[a.Key] = projectID
+		definitions = append(definitions, map[string]interface{}{
+			"key":               a.Key,
+			"name":              project.Name,
+			"description":       project.Description,
+			"business_unit":     project.BusinessUnit,
+			"parent_project_id": a.ParentProjectID,
+		})
+	}
+
+	known := make(map[string]bool)
+	for _, a := range current {
+		known[a.Key] = true
+		flatten(a)
+	}
+	var unknown []string
+	for key := range projectIDs {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		flatten(projectDefinition{Key: key})
+	}
+
+	return definitions, existingIDs
+}
+
+func resourceWizProjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizProjectsUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = reconcileProjects(ctx, d, m)
+
+	return append(diags, resourceWizProjectsRead(ctx, d, m)...)
+}
+
+func resourceWizProjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizProjectsDelete called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	projectIDs := make(map[string]interface{})
+	var keys []string
+	for k, v := range d.Get("project_ids").(map[string]interface{}) {
+		projectIDs[k] = v
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	diags = removeProjects(ctx, m, keys, projectIDs, d.Get("delete_behavior").(string))
+
+	// keep the projects that could not be removed, so destroying again retries them
+	err := d.Set("project_ids", projectIDs)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
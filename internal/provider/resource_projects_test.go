@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func TestDiffProjectDefinitions(t *testing.T) {
+	previous := []projectDefinition{
+		{Key: "dev", Name: "Development"},
+		{Key: "test", Name: "Test"},
+		{Key: "stage", Name: "Staging"},
+	}
+	desired := []projectDefinition{
+		{Key: "dev", Name: "Development"},
+		{Key: "test", Name: "Test", BusinessUnit: "QA"},
+		{Key: "stage", Name: "Staging"},
+		{Key: "prod", Name: "Production"},
+	}
+	// stage failed to create on the previous apply, old was removed from the configuration
+	projectIDs := map[string]interface{}{
+		"dev":  "dd5a2e2c-4f1b-4b63-9a8e-1c3b1f2e0a01",
+		"test": "7c1f4e2a-3b6d-4e8f-8a9b-2d4c6e8f0a02",
+		"old":  "a3e5c7b9-1d2f-4a6b-8c0d-3e5f7a9b1c03",
+	}
+
+	expected := projectChanges{
+		Create: []projectDefinition{
+			{Key: "stage", Name: "Staging"},
+			{Key: "prod", Name: "Production"},
+		},
+		Update: []projectDefinition{
+			{Key: "test", Name: "Test", BusinessUnit: "QA"},
+		},
+		Remove: []string{"old"},
+	}
+
+	changes := diffProjectDefinitions(desired, previous, projectIDs)
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			changes,
+			expected,
+		)
+	}
+}
+
+func TestFlattenProjectDefinitions(t *testing.T) {
+	current := []projectDefinition{
+		{Key: "dev", Name: "Development", ParentProjectID: "5b7d9f1a-3c5e-4a7b-9d1f-4a6c8e0b2d04"},
+		{Key: "test", Name: "Test"},
+		{Key: "new", Name: "New"},
+	}
+	projectIDs := map[string]interface{}{
+		"dev":      "dd5a2e2c-4f1b-4b63-9a8e-1c3b1f2e0a01",
+		"test":     "7c1f4e2a-3b6d-4e8f-8a9b-2d4c6e8f0a02",
+		"archived": "a3e5c7b9-1d2f-4a6b-8c0d-3e5f7a9b1c03",
+		"removed":  "c9e1a3b5-7d9f-4b1c-8e2a-5f7b9d1e3a05",
+	}
+	projects := map[string]*wiz.Project{
+		"dd5a2e2c-4f1b-4b63-9a8e-1c3b1f2e0a01": {
+			ID:           "dd5a2e2c-4f1b-4b63-9a8e-1c3b1f2e0a01",
+			Name:         "Development (renamed)",
+			BusinessUnit: "Engineering",
+		},
+		"7c1f4e2a-3b6d-4e8f-8a9b-2d4c6e8f0a02": {
+			ID:   "7c1f4e2a-3b6d-4e8f-8a9b-2d4c6e8f0a02",
+			Name: "Test",
+		},
+		"a3e5c7b9-1d2f-4a6b-8c0d-3e5f7a9b1c03": {
+			ID:       "a3e5c7b9-1d2f-4a6b-8c0d-3e5f7a9b1c03",
+			Name:     "Archived",
+			Archived: true,
+		},
+	}
+
+	expectedDefinitions := []interface{}{
+		map[string]interface{}{
+			"key":               "dev",
+			"name":              "Development (renamed)",
+			"description":       "",
+			"business_unit":     "Engineering",
+			"parent_project_id": "5b7d9f1a-3c5e-4a7b-9d1f-4a6c8e0b2d04",
+		},
+		map[string]interface{}{
+			"key":               "test",
+			"name":              "Test",
+			"description":       "",
+			"business_unit":     "",
+			"parent_project_id": "",
+		},
+	}
+	expectedIDs := map[string]interface{}{
+		"dev":  "dd5a2e2c-4f1b-4b63-9a8e-1c3b1f2e0a01",
+		"test": "7c1f4e2a-3b6d-4e8f-8a9b-2d4c6e8f0a02",
+	}
+
+	definitions, existingIDs := flattenProjectDefinitions(current, projectIDs, projects)
+	if !reflect.DeepEqual(definitions, expectedDefinitions) || !reflect.DeepEqual(existingIDs, expectedIDs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n%#v\n\nExpected:\n\n%#v\n%#v\n",
+			definitions, existingIDs,
+			expectedDefinitions, expectedIDs,
+		)
+	}
+}
+
+func TestDuplicateProjectKeys(t *testing.T) {
+	projects := []projectDefinition{
+		{Key: "dev"},
+		{Key: "test"},
+		{Key: "dev"},
+		{Key: "dev"},
+	}
+
+	expected := []string{"dev"}
+	duplicates := duplicateProjectKeys(projects)
+	if !reflect.DeepEqual(duplicates, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			duplicates,
+			expected,
+		)
+	}
+}
+
+func TestOnlyErrorCode(t *testing.T) {
+	notFound := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "projects read reported errors",
+		Detail:   `Response: [{"message": "project not found", "extensions": {"code": "NOT_FOUND"}}]`,
+	}
+	forbidden := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "projects read reported errors",
+		Detail:   `Response: [{"message": "project not found", "extensions": {"code": "NOT_FOUND"}}, {"message": "forbidden", "extensions": {"code": "FORBIDDEN"}}]`,
+	}
+
+	if !onlyErrorCode(diag.Diagnostics{notFound}, projectNotFoundErrorCode) {
+		t.Fatalf("Expected only %s errors in: %#v", projectNotFoundErrorCode, notFound)
+	}
+	if onlyErrorCode(diag.Diagnostics{forbidden}, projectNotFoundErrorCode) {
+		t.Fatalf("Expected other errors in: %#v", forbidden)
+	}
+	if onlyErrorCode(diag.FromErr(errors.New("connection reset")), projectNotFoundErrorCode) {
+		t.Fatalf("Expected an error without a code to be reported")
+	}
+}
+
+func TestProjectsCreatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+
+	// the second project fails to create, so the first is deleted again
+	deleted := 0
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				response := `{"data":{"p0":{"id":"2dc9a5ee-b52e-41a2-a13f-75c57d466acf","name":"dev","slug":"dev","archived":false}}}`
+				switch {
+				case strings.Contains(string(body), "deleteProject"):
+					deleted++
+					response = `{"data":{"deleteProject":{"_stub":null}}}`
+				case strings.Contains(string(body), `"name":"dev"`):
+					response = `{"data":{"createProject":{"project":{"id":"2dc9a5ee-b52e-41a2-a13f-75c57d466acf"}}}}`
+				case strings.Contains(string(body), `"name":"prod"`):
+					response = `{"data":{"createProject":null},"errors":[{"message":"name already in use","extensions":{"code":"BAD_USER_INPUT"}}]}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(response)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL: "http://example.com",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceWizProjects().Schema, map[string]interface{}{
+		"project": []interface{}{
+			map[string]interface{}{"key": "dev", "name": "dev"},
+			map[string]interface{}{"key": "prod", "name": "prod"},
+		},
+	})
+
+	diags := resourceWizProjectsCreate(ctx, d, m)
+	if !diags.HasError() || len(diags) != 1 || !strings.HasPrefix(diags[0].Summary, "project prod:") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected an error for project prod\n", diags)
+	}
+
+	if d.Id() != "" || deleted != 1 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			[]interface{}{d.Id(), deleted},
+			[]interface{}{"", 1},
+		)
+	}
+}
@@ -209,7 +209,7 @@ func resourceWizReportGraphQueryRead(ctx context.Context, d *schema.ResourceData
 	tflog.Info(ctx, fmt.Sprintf("report ID during read: %s", vars.ID))
 
 	data := &ReadReportPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "report", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "report", func() bool { return data.Report.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
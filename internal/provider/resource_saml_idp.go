@@ -3,108 +3,42 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
 func resourceWizSAMLIdP() *schema.Resource {
 	return &schema.Resource{
-		Description: "Configure SAML Providers and associated resources (group mappings).",
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:        schema.TypeString,
-				Description: "Internal identifier for the Saml Provider",
-				Computed:    true,
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Description: "IdP name to display in Wiz.",
-				Required:    true,
-			},
-			"issuer_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "If undefined, this will default to the login_url value. Set to the same value as login_url if unsure what value to use.",
-			},
-			"login_url": {
-				Type:        schema.TypeString,
-				Description: "IdP Login URL",
-				Required:    true,
-			},
-			"logout_url": {
-				Type:        schema.TypeString,
-				Description: "IdP Logout URL",
-				Optional:    true,
-			},
-			"use_provider_managed_roles": {
-				Type:        schema.TypeBool,
-				Description: "When set to true, roles will be provided by the SSO provider. Manage the roles via Wiz portal otherwise.",
-				Optional:    true,
-				Default:     false,
-			},
-			"allow_manual_role_override": {
-				Type:        schema.TypeBool,
-				Description: "When set to true, allow overriding the mapped SSO role for specific users. Must be set `true` if `use_provided_roles` is false.",
-				Optional:    true,
-				Default:     true,
-				RequiredWith: []string{
-					"use_provider_managed_roles",
-				},
-			},
-			"certificate": {
-				Type:        schema.TypeString,
-				Description: "PEM certificate from IdP",
-				Required:    true,
-			},
-			"domains": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "A list of domains the IdP handles.",
-				Deprecated:  "This field is no longer supported by Wiz. If defined, this will result in change detection on every run.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"group_mapping": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Group mappings",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"provider_group_id": {
-							Type:        schema.TypeString,
-							Description: "Provider group ID",
-							Required:    true,
-						},
-						"role": {
-							Type:        schema.TypeString,
-							Description: "Wiz Role name",
-							Required:    true,
-						},
-						"projects": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Project mapping",
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-					},
-				},
-			},
-			"merge_groups_mapping_by_role": {
-				Type:        schema.TypeBool,
-				Description: "Manage group mapping by role?",
-				Optional:    true,
+		Description:   "Configure SAML Providers and associated resources (group mappings).",
+		Schema:        resourceWizSAMLIdPSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceWizSAMLIdPV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceWizSAMLIdPStateUpgradeV0,
 			},
 		},
+		CustomizeDiff: customdiff.All(
+			validateGroupMappingDuplicatesOnPlan,
+			normalizeGroupMappingProjectsOnPlan,
+			validateGroupMappingProjectsOnPlan,
+			validateGroupMappingScopesOnPlan,
+			validateGroupMappingProjectRemovalsOnPlan,
+		),
 		CreateContext: resourceWizSAMLIdPCreate,
 		ReadContext:   resourceWizSAMLIdPRead,
 		UpdateContext: resourceWizSAMLIdPUpdate,
@@ -115,9 +49,149 @@ func resourceWizSAMLIdP() *schema.Resource {
 	}
 }
 
-func getGroupMappingVar(ctx context.Context, d *schema.ResourceData) []*wiz.SAMLGroupMappingCreateInput {
-	groupMapping := d.Get("group_mapping").(*schema.Set).List()
-	var myGroupMappings []*wiz.SAMLGroupMappingCreateInput
+// resourceWizSAMLIdPV0 returns version 0 of the schema, which stored group_mapping as a set
+func resourceWizSAMLIdPV0() *schema.Resource {
+	s := resourceWizSAMLIdPSchema()
+	s["group_mapping"].Type = schema.TypeSet
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+// resourceWizSAMLIdPStateUpgradeV0 orders the group mappings of a version 0 state by provider group and role
+// a set has no order, the next read keeps this order for the mappings
+func resourceWizSAMLIdPStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	tflog.Info(ctx, "resourceWizSAMLIdPStateUpgradeV0 called...")
+
+	mappings, ok := rawState["group_mapping"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+	rawState["group_mapping"] = sortGroupMappings(ctx, nil, mappings)
+	return rawState, nil
+}
+
+func resourceWizSAMLIdPSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Internal identifier for the Saml Provider",
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "IdP name to display in Wiz.",
+			Required:    true,
+		},
+		"issuer_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "If undefined, this will default to the login_url value. Set to the same value as login_url if unsure what value to use.",
+		},
+		"login_url": {
+			Type:        schema.TypeString,
+			Description: "IdP Login URL",
+			Required:    true,
+		},
+		"logout_url": {
+			Type:        schema.TypeString,
+			Description: "IdP Logout URL",
+			Optional:    true,
+		},
+		"use_provider_managed_roles": {
+			Type:        schema.TypeBool,
+			Description: "When set to true, roles will be provided by the SSO provider. Manage the roles via Wiz portal otherwise.",
+			Optional:    true,
+			Default:     false,
+		},
+		"allow_manual_role_override": {
+			Type:        schema.TypeBool,
+			Description: "When set to true, allow overriding the mapped SSO role for specific users. Must be set `true` if `use_provided_roles` is false.",
+			Optional:    true,
+			Default:     true,
+			RequiredWith: []string{
+				"use_provider_managed_roles",
+			},
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Description: "PEM certificate from IdP",
+			Required:    true,
+		},
+		"domains": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "A list of domains the IdP handles.",
+			Deprecated:  "This field is no longer supported by Wiz. If defined, this will result in change detection on every run.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"group_mapping": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Description: "Group mappings. Mappings read from Wiz are matched to the configured mappings by provider group and role, so a change of `projects` is planned as the projects added and removed.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"provider_group_id": {
+						Type:             schema.TypeString,
+						Description:      "Provider group ID",
+						Required:         true,
+						ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+					},
+					"role": {
+						Type:        schema.TypeString,
+						Description: "Wiz Role name",
+						Required:    true,
+					},
+					"projects": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Description: "Project mapping. Each entry is either a project ID or a project slug prefixed with `slug:` (e.g. `slug:my-project`), which is resolved to the project ID during plan. State holds the project ID.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"merge_groups_mapping_by_role": {
+			Type:        schema.TypeBool,
+			Description: "Manage group mapping by role?",
+			Optional:    true,
+		},
+		"deletion_protection": deletionProtectionSchema(),
+		"max_project_removals": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          10,
+			Description:      "Fail the plan of an update that removes more than this number of projects from a group mapping, unless `confirm_project_removal` is set. A removed group mapping counts all of its projects. `0` disables the limit.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"max_project_removal_percent": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          50,
+			Description:      "Fail the plan of an update that removes more than this percentage of the projects of a group mapping, unless `confirm_project_removal` is set. `0` disables the limit.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+		},
+		"confirm_project_removal": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow an update that removes more projects from a group mapping than `max_project_removals` or `max_project_removal_percent`. Set it for the plan and apply that are meant to reduce the scope of the mappings.",
+		},
+		"last_request_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Wiz request ID of the last successful create or update, to look up the change in the Wiz audit log.",
+		},
+	}
+}
+
+func getGroupMappingVar(ctx context.Context, d *schema.ResourceData, m interface{}) (myGroupMappings []*wiz.SAMLGroupMappingCreateInput, diags diag.Diagnostics) {
+	groupMapping := d.Get("group_mapping").([]interface{})
 	for _, a := range groupMapping {
 		tflog.Debug(ctx, fmt.Sprintf("groupMapping: %t %s", a, utils.PrettyPrint(a)))
 		localGroupMapping := &wiz.SAMLGroupMappingCreateInput{}
@@ -130,16 +204,15 @@ func getGroupMappingVar(ctx context.Context, d *schema.ResourceData) []*wiz.SAML
 			case "provider_group_id":
 				localGroupMapping.ProviderGroupID = c.(string)
 			case "projects":
-				for _, f := range c.([]interface{}) {
-					tflog.Trace(ctx, fmt.Sprintf("f: %t %s", f, f))
-					localGroupMapping.Projects = append(localGroupMapping.Projects, f.(string))
-				}
+				projects, projectDiags := resolveProjectSlugs(ctx, m, utils.ConvertListToString(c.(*schema.Set).List()))
+				diags = append(diags, projectDiags...)
+				localGroupMapping.Projects = projects
 			}
 		}
 		myGroupMappings = append(myGroupMappings, localGroupMapping)
 	}
 	tflog.Debug(ctx, fmt.Sprintf("myGroupMappings: %s", utils.PrettyPrint(myGroupMappings)))
-	return myGroupMappings
+	return myGroupMappings, diags
 }
 
 // CreateSAMLIdentityProvider struct
@@ -150,6 +223,16 @@ type CreateSAMLIdentityProvider struct {
 func resourceWizSAMLIdPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	tflog.Info(ctx, "resourceWizSAMLIdPCreate called...")
 
+	// check the referenced projects before creating the identity provider
+	// archived projects are reported as warnings, which are returned with the result of the create
+	var projectDiags diag.Diagnostics
+	if m.(*config.ProviderConf).Settings.ValidateOnPlan {
+		projectDiags = checkGroupMappingProjects(ctx, m, groupMappingProjectIDs(d.Get("group_mapping").([]interface{})))
+		if projectDiags.HasError() {
+			return projectDiags
+		}
+	}
+
 	// define the graphql query
 	query := `mutation CreateSAMLIdentityProvider ($input: CreateSAMLIdentityProviderInput!) {
 	  createSAMLIdentityProvider(
@@ -172,11 +255,39 @@ func resourceWizSAMLIdPCreate(ctx context.Context, d *schema.ResourceData, m int
 	vars.Certificate = d.Get("certificate").(string)
 	vars.MergeGroupsMappingByRole = utils.ConvertBoolToPointer(d.Get("merge_groups_mapping_by_role").(bool))
 	vars.Domains = utils.ConvertListToString(d.Get("domains").([]interface{}))
-	vars.GroupMapping = getGroupMappingVar(ctx, d)
+	groupMapping, groupMappingDiags := getGroupMappingVar(ctx, d, m)
+	diags = append(diags, groupMappingDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// apply the archived project policy to the group mapping projects
+	var mappedProjects []string
+	for _, a := range groupMapping {
+		mappedProjects = append(mappedProjects, a.Projects...)
+	}
+	archived, archivedDiags := readArchivedProjects(ctx, m, utils.Unique(mappedProjects))
+	diags = append(diags, archivedDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	policy := m.(*config.ProviderConf).Settings.ArchivedProjectPolicy
+	for _, a := range groupMapping {
+		var policyDiags diag.Diagnostics
+		a.Projects, policyDiags = applyArchivedProjectPolicy(policy, a.ProviderGroupID, a.Projects, archived)
+		projectDiags = append(projectDiags, policyDiags...)
+	}
+	for _, a := range groupMapping {
+		projectDiags = append(projectDiags, groupMappingProjectLimitDiags(m.(*config.ProviderConf).Settings, a.ProviderGroupID, a.Role, len(a.Projects))...)
+	}
+	if projectDiags.HasError() {
+		return projectDiags
+	}
+	vars.GroupMapping = groupMapping
 
 	// process the request
 	data := &CreateSAMLIdentityProvider{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_idp", "create")
+	requestID, requestDiags := client.ProcessRequestWithRequestID(ctx, m, vars, data, query, "saml_idp", "create")
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		return diags
@@ -185,7 +296,518 @@ func resourceWizSAMLIdPCreate(ctx context.Context, d *schema.ResourceData, m int
 	// set the id
 	d.SetId(data.CreateSAMLIdentityProvider.SAMLIdentityProvider.ID)
 
-	return resourceWizSAMLIdPRead(ctx, d, m)
+	err := d.Set("last_request_id", requestID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// trust the mutation result, state keeps the configured values until the next refresh
+	if m.(*config.ProviderConf).Settings.SAMLIdPSkipReadAfterWrite {
+		return projectDiags
+	}
+
+	// make sure Wiz applied every project of the group mappings
+	readDiags := resourceWizSAMLIdPRead(ctx, d, m)
+	if readDiags.HasError() || d.Id() == "" {
+		return append(projectDiags, readDiags...)
+	}
+	return append(append(projectDiags, readDiags...), unappliedGroupMappingProjects(groupMapping, d.Get("group_mapping").([]interface{}))...)
+}
+
+// unappliedGroupMappingProjects returns an error for each group mapping whose projects were not all stored by Wiz, sorted by group and role
+// stored holds the group mappings as read into state
+func unappliedGroupMappingProjects(sent []*wiz.SAMLGroupMappingCreateInput, stored []interface{}) (diags diag.Diagnostics) {
+	storedProjects := make(map[groupMappingKey]map[string]bool)
+	for key, projects := range groupMappingProjectsByKey(stored) {
+		storedProjects[key] = make(map[string]bool)
+		for _, p := range projects {
+			storedProjects[key][p] = true
+		}
+	}
+
+	missing := make(map[groupMappingKey][]string)
+	var keys []groupMappingKey
+	for _, a := range sent {
+		key := groupMappingKey{a.ProviderGroupID, a.Role}
+		for _, project := range a.Projects {
+			if storedProjects[key][project] {
+				continue
+			}
+			if missing[key] == nil {
+				keys = append(keys, key)
+			}
+			missing[key] = append(missing[key], project)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].group != keys[j].group {
+			return keys[i].group < keys[j].group
+		}
+		return keys[i].role < keys[j].role
+	})
+
+	for _, key := range keys {
+		projects := utils.Unique(missing[key])
+		sort.Strings(projects)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Group mapping projects were not applied",
+			Detail:   fmt.Sprintf("Wiz did not apply projects %s to the mapping of group %s to role %s. Members of the group do not have the role on these projects; check whether the projects exist and whether Wiz limits the number of projects per mapping.", strings.Join(projects, ", "), key.group, key.role),
+		})
+	}
+	return diags
+}
+
+// groupMappingProjectIDs returns the project IDs referenced by the group mappings
+// slug references are checked when they are resolved, and values that are unknown during plan are skipped
+func groupMappingProjectIDs(groupMappings []interface{}) (projectIDs []string) {
+	for _, a := range groupMappings {
+		mapping, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		projects, ok := mapping["projects"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, p := range utils.ConvertListToString(projects.List()) {
+			if p == "" || strings.HasPrefix(p, projectSlugPrefix) {
+				continue
+			}
+			projectIDs = append(projectIDs, p)
+		}
+	}
+	return utils.Unique(projectIDs)
+}
+
+// checkGroupMappingProjects confirms that the referenced projects exist and are not archived
+func checkGroupMappingProjects(ctx context.Context, m interface{}, projectIDs []string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "checkGroupMappingProjects called...")
+
+	if len(projectIDs) == 0 {
+		return diags
+	}
+
+	// only the referenced projects are read, in batches, so large tenants and long project lists stay fast
+	projects, diags := readProjectsByID(ctx, m, projectIDs)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return projectReferenceDiags(projectIDs, projects)
+}
+
+// readProjectsIncludingArchived returns the id and archived state of every project
+func readProjectsIncludingArchived(ctx context.Context, m interface{}) (allData []interface{}, diags diag.Diagnostics) {
+	tflog.Info(ctx, "readProjectsIncludingArchived called...")
+
+	// define the graphql query
+	query := `query projects (
+	    $first: Int
+	    $after: String
+	    $filterBy: ProjectFilters
+	) {
+	    projects(
+	        first: $first
+	        after: $after
+	        filterBy: $filterBy
+	    ) {
+	        nodes {
+	            id
+	            archived
+	        }
+	        pageInfo {
+	            endCursor
+	            hasNextPage
+	        }
+	        totalCount
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.First = 500
+	vars.FilterBy = &wiz.ProjectFilters{
+		IncludeArchived: utils.ConvertBoolToPointer(true),
+	}
+
+	// process the request
+	data := &ReadProjects{}
+	requestDiags, allData := client.ProcessPagedRequest(ctx, m, vars, data, query, "project", "read", 0)
+	diags = append(diags, requestDiags...)
+
+	return allData, diags
+}
+
+// readArchivedProjects returns the set of archived projects when any of projectIDs need to be checked
+// nothing is read with the default keep policy, since archived projects are then sent to Wiz unchanged
+func readArchivedProjects(ctx context.Context, m interface{}, projectIDs []string) (archived map[string]bool, diags diag.Diagnostics) {
+	tflog.Info(ctx, "readArchivedProjects called...")
+
+	archived = make(map[string]bool)
+	policy := m.(*config.ProviderConf).Settings.ArchivedProjectPolicy
+	if (policy != "drop" && policy != "error") || len(projectIDs) == 0 {
+		return archived, diags
+	}
+
+	allData, diags := readProjectsIncludingArchived(ctx, m)
+	if len(diags) > 0 {
+		return archived, diags
+	}
+	for _, a := range allData {
+		for _, b := range a.(*ReadProjects).Projects.Nodes {
+			if b.Archived {
+				archived[b.ID] = true
+			}
+		}
+	}
+	return archived, diags
+}
+
+// applyArchivedProjectPolicy returns the projects of a group mapping with the archived_project_policy applied
+// drop removes archived projects with a warning, error reports an error for each archived project
+func applyArchivedProjectPolicy(policy string, providerGroupID string, projects []string, archived map[string]bool) (output []string, diags diag.Diagnostics) {
+	if len(archived) == 0 {
+		return projects, diags
+	}
+	output = make([]string, 0, len(projects))
+	for _, p := range projects {
+		if !archived[p] {
+			output = append(output, p)
+			continue
+		}
+		switch policy {
+		case "drop":
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Archived project dropped from group mapping",
+				Detail:   fmt.Sprintf("Group mapping for %s references project %s, which is archived. It was left out of the mapping sent to Wiz.", providerGroupID, p),
+			})
+		case "error":
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Project is archived",
+				Detail:   fmt.Sprintf("Group mapping for %s references project %s, which is archived. Remove it from the mapping, or set archived_project_policy to keep or drop.", providerGroupID, p),
+			})
+		default:
+			output = append(output, p)
+		}
+	}
+	return output, diags
+}
+
+// groupMappingProjectLimitDiags reports a group mapping with more projects than group_mapping_project_limit
+// the diagnostic is a warning or an error according to group_mapping_project_limit_policy
+func groupMappingProjectLimitDiags(settings *config.Settings, providerGroupID string, role string, projects int) (diags diag.Diagnostics) {
+	if settings.GroupMappingProjectLimit == 0 || projects <= settings.GroupMappingProjectLimit {
+		return diags
+	}
+	severity := diag.Warning
+	if settings.GroupMappingProjectLimitPolicy == "error" {
+		severity = diag.Error
+	}
+	return append(diags, diag.Diagnostic{
+		Severity: severity,
+		Summary:  "Group mapping has too many projects",
+		Detail:   fmt.Sprintf("The mapping of group %s to role %s has %d projects, more than group_mapping_project_limit of %d. Wiz may not apply all of them; split the projects across mappings or raise the limit.", providerGroupID, role, projects, settings.GroupMappingProjectLimit),
+	})
+}
+
+// droppedGroupMappingProjects returns the configured project IDs that are missing from the flattened group mappings
+func droppedGroupMappingProjects(configured []interface{}, flattened []interface{}) (projectIDs []string) {
+	returned := make(map[string]bool)
+	for _, a := range flattened {
+		mapping := a.(map[string]interface{})
+		for _, p := range utils.ConvertListToString(mapping["projects"].([]interface{})) {
+			returned[fmt.Sprintf("%s/%s/%s", mapping["provider_group_id"], mapping["role"], p)] = true
+		}
+	}
+	for _, a := range configured {
+		mapping := a.(map[string]interface{})
+		for _, p := range utils.ConvertListToString(mapping["projects"].(*schema.Set).List()) {
+			if strings.HasPrefix(p, projectSlugPrefix) {
+				continue
+			}
+			if !returned[fmt.Sprintf("%s/%s/%s", mapping["provider_group_id"], mapping["role"], p)] {
+				projectIDs = append(projectIDs, p)
+			}
+		}
+	}
+	return utils.Unique(projectIDs)
+}
+
+// keepDroppedArchivedProjects adds archived projects that were dropped from the mappings sent to Wiz back to the flattened group mappings
+// with archived_project_policy set to drop, this keeps the configuration from showing drift for the projects it deliberately left out
+func keepDroppedArchivedProjects(configured []interface{}, flattened []interface{}, archived map[string]bool) {
+	for _, a := range flattened {
+		mapping := a.(map[string]interface{})
+		projects := mapping["projects"].([]interface{})
+		present := make(map[string]bool)
+		for _, p := range projects {
+			present[p.(string)] = true
+		}
+		for _, b := range configured {
+			configuredMapping := b.(map[string]interface{})
+			if configuredMapping["provider_group_id"] != mapping["provider_group_id"] || configuredMapping["role"] != mapping["role"] {
+				continue
+			}
+			for _, p := range utils.ConvertListToString(configuredMapping["projects"].(*schema.Set).List()) {
+				if archived[p] && !present[p] {
+					projects = append(projects, p)
+					present[p] = true
+				}
+			}
+		}
+		mapping["projects"] = projects
+	}
+}
+
+// projectReferenceDiags reports an error for each project ID that does not exist and a warning for each archived project
+// all missing and archived projects are reported together
+func projectReferenceDiags(projectIDs []string, projects map[string]*wiz.Project) (diags diag.Diagnostics) {
+	for _, id := range projectIDs {
+		project, ok := projects[id]
+		switch {
+		case !ok:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Project not found",
+				Detail:   fmt.Sprintf("Group mapping references project %s, which does not exist.", id),
+			})
+		case project.Archived:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Project is archived",
+				Detail:   fmt.Sprintf("Group mapping references project %s, which is archived. Wiz may ignore the mapping for this project.", id),
+			})
+		}
+	}
+	return diags
+}
+
+// validateGroupMappingDuplicatesOnPlan fails the plan when two group mappings map the same group to the same role and projects
+// the raw configuration is checked, so the mappings are compared as written and before the plan changes them
+func validateGroupMappingDuplicatesOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+	configured := rawConfig.GetAttr("group_mapping")
+	if configured.IsNull() || !configured.IsKnown() {
+		return nil
+	}
+
+	errs := duplicateGroupMappings(rawGroupMappings(configured))
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// rawGroupMappings converts the configured group mappings to the form returned by the schema, known reports the mappings without unknown values
+func rawGroupMappings(configured cty.Value) (mappings []interface{}, known []bool) {
+	for it := configured.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if !v.IsWhollyKnown() {
+			mappings = append(mappings, nil)
+			known = append(known, false)
+			continue
+		}
+		var projects []interface{}
+		if p := v.GetAttr("projects"); !p.IsNull() {
+			for pit := p.ElementIterator(); pit.Next(); {
+				_, project := pit.Element()
+				projects = append(projects, project.AsString())
+			}
+		}
+		mappings = append(mappings, map[string]interface{}{
+			"provider_group_id": v.GetAttr("provider_group_id").AsString(),
+			"role":              v.GetAttr("role").AsString(),
+			"projects":          schema.NewSet(schema.HashString, projects),
+		})
+		known = append(known, true)
+	}
+	return mappings, known
+}
+
+// duplicateGroupMappings returns an error message for each group mapping that repeats an earlier one, mappings that are not known are skipped
+func duplicateGroupMappings(mappings []interface{}, known []bool) []string {
+	var errs []string
+	seen := make(map[string]int)
+	for i, a := range mappings {
+		if !known[i] {
+			continue
+		}
+		mapping := a.(map[string]interface{})
+		projects := utils.ConvertListToString(mapping["projects"].(*schema.Set).List())
+		sort.Strings(projects)
+		key := strings.Join(append([]string{mapping["provider_group_id"].(string), mapping["role"].(string)}, projects...), "\x00")
+		if first, ok := seen[key]; ok {
+			errs = append(errs, fmt.Sprintf("group_mapping.%d and group_mapping.%d both map group %s to role %s with the same projects", first, i, mapping["provider_group_id"], mapping["role"]))
+			continue
+		}
+		seen[key] = i
+	}
+	return errs
+}
+
+// normalizeGroupMappingProjectsOnPlan plans the project IDs of `slug:` project references, so state holds the same IDs as Wiz
+// a slug that resolves to the stored ID plans no change; group_mapping is computed, so removing every mapping is planned here
+func normalizeGroupMappingProjectsOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// mappings that are not known yet are resolved at apply
+	if !groupMappingsKnown(d) {
+		return nil
+	}
+
+	// a computed block keeps its previous value when it is removed from the configuration
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsNull() {
+		configured := rawConfig.GetAttr("group_mapping")
+		if configured.IsNull() || configured.LengthInt() == 0 {
+			if len(d.Get("group_mapping").([]interface{})) == 0 {
+				return nil
+			}
+			return d.SetNew("group_mapping", []interface{}{})
+		}
+	}
+
+	var slugs bool
+	mappings := d.Get("group_mapping").([]interface{})
+	for _, p := range groupMappingProjectReferences(mappings) {
+		if strings.HasPrefix(p, projectSlugPrefix) {
+			slugs = true
+			break
+		}
+	}
+	if !slugs {
+		return nil
+	}
+
+	normalized := make([]interface{}, 0, len(mappings))
+	for _, a := range mappings {
+		mapping := a.(map[string]interface{})
+		var projects []string
+		if set, ok := mapping["projects"].(*schema.Set); ok {
+			projects = utils.ConvertListToString(set.List())
+		}
+		resolved, diags := resolveProjectSlugs(ctx, m, projects)
+		if diags.HasError() {
+			return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("planned projects %s for %s/%s", utils.PrettyPrint(resolved), mapping["provider_group_id"], mapping["role"]))
+		normalized = append(normalized, map[string]interface{}{
+			"provider_group_id": mapping["provider_group_id"],
+			"role":              mapping["role"],
+			"projects":          utils.ConvertSliceToGenericArray(resolved),
+		})
+	}
+	return d.SetNew("group_mapping", normalized)
+}
+
+// groupMappingProjectReferences returns the project references of the group mappings, IDs and `slug:` references alike
+func groupMappingProjectReferences(groupMappings []interface{}) (references []string) {
+	for _, projects := range groupMappingProjectsByKey(groupMappings) {
+		references = append(references, projects...)
+	}
+	return references
+}
+
+// validateGroupMappingProjectsOnPlan checks the projects of changed group mappings when validate_on_plan is enabled
+// a plan cannot carry warnings, so archived projects are only logged here and reported as warnings at create
+func validateGroupMappingProjectsOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	conf, ok := m.(*config.ProviderConf)
+	if !ok || conf.Settings == nil || !conf.Settings.ValidateOnPlan || !d.HasChange("group_mapping") {
+		return nil
+	}
+
+	var errs []string
+	for _, e := range checkGroupMappingProjects(ctx, m, groupMappingProjectIDs(d.Get("group_mapping").([]interface{}))) {
+		if e.Severity == diag.Warning {
+			tflog.Warn(ctx, e.Detail)
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", e.Summary, e.Detail))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// validateGroupMappingScopesOnPlan fails the plan when a group mapping without projects is assigned a role with a scope listed in forbidden_scope_project_combos
+func validateGroupMappingScopesOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	conf, ok := m.(*config.ProviderConf)
+	if !ok || conf.Settings == nil || len(conf.Settings.ForbiddenScopeProjectCombos) == 0 || !d.HasChange("group_mapping") {
+		return nil
+	}
+
+	// mappings that are not known yet cannot be checked
+	if !groupMappingsKnown(d) {
+		return nil
+	}
+
+	// collect the roles of the group mappings that apply to all projects
+	var mappings []map[string]interface{}
+	for _, a := range d.Get("group_mapping").([]interface{}) {
+		mapping := a.(map[string]interface{})
+		if mapping["projects"].(*schema.Set).Len() > 0 {
+			continue
+		}
+		mappings = append(mappings, mapping)
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	roleScopes, diags := readRoleScopes(ctx, m)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read role scopes: %s", diags[0].Summary)
+	}
+
+	var errs []string
+	for _, mapping := range mappings {
+		role := mapping["role"].(string)
+		forbidden := forbiddenGlobalScopes(roleScopes[role], conf.Settings.ForbiddenScopeProjectCombos)
+		if len(forbidden) > 0 {
+			errs = append(errs, fmt.Sprintf("group mapping for %s grants role %s on all projects, which includes forbidden scopes: %s", mapping["provider_group_id"], role, strings.Join(forbidden, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// readRoleScopes returns the scopes of every role, keyed by role identifier
+func readRoleScopes(ctx context.Context, m interface{}) (map[string][]string, diag.Diagnostics) {
+	tflog.Info(ctx, "readRoleScopes called...")
+
+	roles, diags := readUserRoles(ctx, m)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	roleScopes := make(map[string][]string)
+	for _, b := range roles {
+		roleScopes[b.ID] = b.Scopes
+	}
+	return roleScopes, diags
+}
+
+// forbiddenGlobalScopes returns the scopes of a role that are forbidden from being granted on all projects, sorted
+func forbiddenGlobalScopes(roleScopes []string, forbiddenScopes []string) []string {
+	forbidden := make(map[string]bool)
+	for _, scope := range forbiddenScopes {
+		forbidden[scope] = true
+	}
+	var output []string
+	for _, scope := range utils.Unique(roleScopes) {
+		if forbidden[scope] {
+			output = append(output, scope)
+		}
+	}
+	sort.Strings(output)
+	return output
 }
 
 func flattenGroupMapping(ctx context.Context, samlGroupMapping []*wiz.SAMLGroupMapping) []interface{} {
@@ -210,6 +832,41 @@ func flattenGroupMapping(ctx context.Context, samlGroupMapping []*wiz.SAMLGroupM
 	return output
 }
 
+// sortGroupMappings orders the flattened group mappings to match the mappings already known to Terraform
+// Wiz does not guarantee the order of group mappings, mappings not known to Terraform are appended in provider group and role order
+func sortGroupMappings(ctx context.Context, known []interface{}, flattened []interface{}) []interface{} {
+	tflog.Info(ctx, "sortGroupMappings called...")
+
+	groupMappingKey := func(v interface{}) string {
+		mapping := v.(map[string]interface{})
+		return fmt.Sprintf("%s/%s", mapping["provider_group_id"], mapping["role"])
+	}
+
+	position := make(map[string]int)
+	for i, a := range known {
+		key := groupMappingKey(a)
+		if _, ok := position[key]; !ok {
+			position[key] = i
+		}
+	}
+
+	output := make([]interface{}, len(flattened))
+	copy(output, flattened)
+	sort.SliceStable(output, func(i, j int) bool {
+		pi, iKnown := position[groupMappingKey(output[i])]
+		pj, jKnown := position[groupMappingKey(output[j])]
+		switch {
+		case iKnown && jKnown:
+			return pi < pj
+		case iKnown != jKnown:
+			return iKnown
+		default:
+			return groupMappingKey(output[i]) < groupMappingKey(output[j])
+		}
+	})
+	return output
+}
+
 // ReadSAMLIdentityProviderPayload struct -- updates
 type ReadSAMLIdentityProviderPayload struct {
 	SAMLIdentityProvider wiz.SAMLIdentityProvider `json:"samlIdentityProvider"`
@@ -258,7 +915,7 @@ func resourceWizSAMLIdPRead(ctx context.Context, d *schema.ResourceData, m inter
 	// this query returns http 200 with a payload that contains errors and a null data body
 	// error message: oops! an internal error has occurred. for reference purposes, this is your request id
 	data := &ReadSAMLIdentityProviderPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_idp", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "saml_idp", func() bool { return data.SAMLIdentityProvider.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -310,6 +967,15 @@ func resourceWizSAMLIdPRead(ctx context.Context, d *schema.ResourceData, m inter
 		return append(diags, diag.FromErr(err)...)
 	}
 	groupMappings := flattenGroupMapping(ctx, data.SAMLIdentityProvider.GroupMapping)
+	if m.(*config.ProviderConf).Settings.ArchivedProjectPolicy == "drop" {
+		dropped := droppedGroupMappingProjects(d.Get("group_mapping").([]interface{}), groupMappings)
+		archived, archivedDiags := readArchivedProjects(ctx, m, dropped)
+		if len(archivedDiags) > 0 {
+			return append(diags, archivedDiags...)
+		}
+		keepDroppedArchivedProjects(d.Get("group_mapping").([]interface{}), groupMappings, archived)
+	}
+	groupMappings = sortGroupMappings(ctx, d.Get("group_mapping").([]interface{}), groupMappings)
 	tflog.Debug(ctx, fmt.Sprintf("groupMappings: %s", utils.PrettyPrint(groupMappings)))
 	if err := d.Set("group_mapping", groupMappings); err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -331,6 +997,11 @@ func resourceWizSAMLIdPUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return nil
 	}
 
+	// deletion_protection and the project removal limits are only kept in state
+	if !d.HasChangesExcept("deletion_protection", "max_project_removals", "max_project_removal_percent", "confirm_project_removal") {
+		return resourceWizSAMLIdPRead(ctx, d, m)
+	}
+
 	// define the graphql query
 	query := `mutation UpdateSAMLIdentityProvider($input: UpdateSAMLIdentityProviderInput!) {
 	    updateSAMLIdentityProvider(input: $input) {
@@ -352,7 +1023,7 @@ func resourceWizSAMLIdPUpdate(ctx context.Context, d *schema.ResourceData, m int
 	vars.Patch.Certificate = d.Get("certificate").(string)
 	vars.Patch.MergeGroupsMappingByRole = utils.ConvertBoolToPointer(d.Get("merge_groups_mapping_by_role").(bool))
 	// populate the group mapping
-	mappings := d.Get("group_mapping").(*schema.Set).List()
+	mappings := d.Get("group_mapping").([]interface{})
 	mappingUpdates := make([]wiz.SAMLGroupMappingUpdateInput, 0)
 	for a, b := range mappings {
 		var myMap = wiz.SAMLGroupMappingUpdateInput{}
@@ -366,25 +1037,183 @@ func resourceWizSAMLIdPUpdate(ctx context.Context, d *schema.ResourceData, m int
 			case "provider_group_id":
 				myMap.ProviderGroupID = d.(string)
 			case "projects":
-				for _, f := range d.([]interface{}) {
-					tflog.Trace(ctx, fmt.Sprintf("f: %t %s", f, f))
-					myMap.Projects = append(myMap.Projects, f.(string))
-				}
+				projects, projectDiags := resolveProjectSlugs(ctx, m, utils.ConvertListToString(d.(*schema.Set).List()))
+				diags = append(diags, projectDiags...)
+				myMap.Projects = projects
 			}
 		}
 		mappingUpdates = append(mappingUpdates, myMap)
 	}
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// apply the archived project policy to the group mapping projects
+	var mappedProjects []string
+	for _, a := range mappingUpdates {
+		mappedProjects = append(mappedProjects, a.Projects...)
+	}
+	archived, archivedDiags := readArchivedProjects(ctx, m, utils.Unique(mappedProjects))
+	diags = append(diags, archivedDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+	var projectDiags diag.Diagnostics
+	policy := m.(*config.ProviderConf).Settings.ArchivedProjectPolicy
+	for i := range mappingUpdates {
+		var policyDiags diag.Diagnostics
+		mappingUpdates[i].Projects, policyDiags = applyArchivedProjectPolicy(policy, mappingUpdates[i].ProviderGroupID, mappingUpdates[i].Projects, archived)
+		projectDiags = append(projectDiags, policyDiags...)
+	}
+	for _, a := range mappingUpdates {
+		projectDiags = append(projectDiags, groupMappingProjectLimitDiags(m.(*config.ProviderConf).Settings, a.ProviderGroupID, a.Role, len(a.Projects))...)
+	}
+	if projectDiags.HasError() {
+		return projectDiags
+	}
 	vars.Patch.GroupMapping = mappingUpdates
 
 	// process the request
 	data := &UpdateSAMLIdentityProvider{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_idp", "update")
+	requestID, requestDiags := client.ProcessRequestWithRequestID(ctx, m, vars, data, query, "saml_idp", "update")
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		return diags
 	}
 
-	return resourceWizSAMLIdPRead(ctx, d, m)
+	err := d.Set("last_request_id", requestID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	// trust the mutation result, state keeps the configured values until the next refresh
+	if m.(*config.ProviderConf).Settings.SAMLIdPSkipReadAfterWrite {
+		return projectDiags
+	}
+
+	// make sure Wiz applied every project of the group mappings
+	sent := make([]*wiz.SAMLGroupMappingCreateInput, 0, len(mappingUpdates))
+	for _, a := range mappingUpdates {
+		mapping := wiz.SAMLGroupMappingCreateInput(a)
+		sent = append(sent, &mapping)
+	}
+	readDiags := resourceWizSAMLIdPRead(ctx, d, m)
+	if readDiags.HasError() || d.Id() == "" {
+		return append(projectDiags, readDiags...)
+	}
+	return append(append(projectDiags, readDiags...), unappliedGroupMappingProjects(sent, d.Get("group_mapping").([]interface{}))...)
+}
+
+// groupMappingsKnown reports whether every value of the planned group mappings is known
+// a mapping with an unknown value is not reported by NewValueKnown, so the raw configuration is checked as well
+func groupMappingsKnown(d *schema.ResourceDiff) bool {
+	if !d.NewValueKnown("group_mapping") {
+		return false
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return true
+	}
+	return rawConfig.GetAttr("group_mapping").IsWhollyKnown()
+}
+
+// validateGroupMappingProjectRemovalsOnPlan fails the plan of an update that removes more projects from a group mapping than the limits allow, unless confirm_project_removal is set
+// mappings that are not known during plan are checked when the plan is repeated at apply, before the update is sent
+func validateGroupMappingProjectRemovalsOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("group_mapping") || d.Get("confirm_project_removal").(bool) || !groupMappingsKnown(d) {
+		return nil
+	}
+
+	previous, current := d.GetChange("group_mapping")
+	removals := groupMappingProjectRemovals(previous.([]interface{}), current.([]interface{}))
+
+	var errs []string
+	for _, e := range projectRemovalDiags(removals, d.Get("max_project_removals").(int), d.Get("max_project_removal_percent").(int)) {
+		errs = append(errs, fmt.Sprintf("%s: %s", e.Summary, e.Detail))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// groupMappingProjectRemoval struct -- the projects removed from the group mappings of a provider group and role
+type groupMappingProjectRemoval struct {
+	ProviderGroupID string
+	Role            string
+	Removed         []string
+	Total           int
+}
+
+// groupMappingKey identifies the group mappings of a provider group and role
+type groupMappingKey struct {
+	group string
+	role  string
+}
+
+// groupMappingProjectsByKey returns the projects of the group mappings, keyed by provider group and role
+func groupMappingProjectsByKey(mappings []interface{}) map[groupMappingKey][]string {
+	projects := make(map[groupMappingKey][]string)
+	for _, a := range mappings {
+		mapping := a.(map[string]interface{})
+		key := groupMappingKey{mapping["provider_group_id"].(string), mapping["role"].(string)}
+		var list []string
+		if set, ok := mapping["projects"].(*schema.Set); ok {
+			list = utils.ConvertListToString(set.List())
+		}
+		projects[key] = append(projects[key], list...)
+	}
+	return projects
+}
+
+// groupMappingProjectRemovals returns the projects removed from each group mapping, sorted by provider group and role
+// mappings are matched by provider group and role, a mapping that no longer restricts projects applies to all projects and removes none
+func groupMappingProjectRemovals(previous []interface{}, current []interface{}) []groupMappingProjectRemoval {
+	previousProjects := groupMappingProjectsByKey(previous)
+	currentProjects := groupMappingProjectsByKey(current)
+
+	var removals []groupMappingProjectRemoval
+	for key, projects := range previousProjects {
+		projects = utils.Unique(projects)
+		remaining, ok := currentProjects[key]
+		if ok && len(remaining) == 0 {
+			continue
+		}
+		removed := utils.Missing(remaining, projects)
+		if len(removed) == 0 {
+			continue
+		}
+		sort.Strings(removed)
+		removals = append(removals, groupMappingProjectRemoval{
+			ProviderGroupID: key.group,
+			Role:            key.role,
+			Removed:         removed,
+			Total:           len(projects),
+		})
+	}
+	sort.Slice(removals, func(i, j int) bool {
+		if removals[i].ProviderGroupID != removals[j].ProviderGroupID {
+			return removals[i].ProviderGroupID < removals[j].ProviderGroupID
+		}
+		return removals[i].Role < removals[j].Role
+	})
+	return removals
+}
+
+// projectRemovalDiags reports an error for each group mapping that loses more projects than the limits allow, a limit of 0 is disabled
+func projectRemovalDiags(removals []groupMappingProjectRemoval, maxRemovals int, maxPercent int) (diags diag.Diagnostics) {
+	for _, r := range removals {
+		percent := len(r.Removed) * 100 / r.Total
+		if (maxRemovals == 0 || len(r.Removed) <= maxRemovals) && (maxPercent == 0 || percent <= maxPercent) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Too many projects removed from group mapping",
+			Detail:   fmt.Sprintf("The update removes %d of %d projects (%d%%) from the mapping of group %s to role %s: %s. Members of the group lose the role on these projects. Set confirm_project_removal to true to apply the removal.", len(r.Removed), r.Total, percent, r.ProviderGroupID, r.Role, strings.Join(r.Removed, ", ")),
+		})
+	}
+	return diags
 }
 
 // DeleteSAMLIdentityProvider struct
@@ -400,6 +1229,12 @@ func resourceWizSAMLIdPDelete(ctx context.Context, d *schema.ResourceData, m int
 		return nil
 	}
 
+	// refuse to destroy protected resources
+	diags = deletionProtectionDiags(d, "wiz_saml_idp")
+	if len(diags) > 0 {
+		return diags
+	}
+
 	// define the graphql query
 	query := `mutation DeleteSAMLIdentityProvider (
 	    $input: DeleteSAMLIdentityProviderInput!
@@ -1,13 +1,23 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
@@ -142,7 +152,10 @@ func TestGetGroupMappingVar(t *testing.T) {
 		},
 	)
 
-	groupMapping := getGroupMappingVar(ctx, d)
+	groupMapping, diags := getGroupMappingVar(ctx, d, &config.ProviderConf{})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %#v", diags)
+	}
 
 	sort.SliceStable(expected, func(i, j int) bool { return expected[i].Role < expected[j].Role })
 	sort.SliceStable(groupMapping, func(i, j int) bool { return groupMapping[i].Role < groupMapping[j].Role })
@@ -155,3 +168,816 @@ func TestGetGroupMappingVar(t *testing.T) {
 		)
 	}
 }
+
+func TestSortGroupMappings(t *testing.T) {
+	ctx := context.Background()
+
+	var known = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "group-b",
+			"role":              "PROJECT_READER",
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-a",
+			"role":              "PROJECT_ADMIN",
+		},
+	}
+
+	var flattened = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "group-c",
+			"role":              "GLOBAL_READER",
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-a",
+			"role":              "PROJECT_ADMIN",
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-b",
+			"role":              "PROJECT_READER",
+		},
+	}
+
+	var expected = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "group-b",
+			"role":              "PROJECT_READER",
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-a",
+			"role":              "PROJECT_ADMIN",
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-c",
+			"role":              "GLOBAL_READER",
+		},
+	}
+
+	sorted := sortGroupMappings(ctx, known, flattened)
+
+	if !reflect.DeepEqual(expected, sorted) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			utils.PrettyPrint(sorted),
+			utils.PrettyPrint(expected),
+		)
+	}
+}
+
+func TestResourceWizSAMLIdPStateUpgradeV0(t *testing.T) {
+	ctx := context.Background()
+
+	rawState := map[string]interface{}{
+		"id": "e9a5c2a5-6b0e-4c5a-9f7a-3e1b2c4d5e6f",
+		"group_mapping": []interface{}{
+			map[string]interface{}{
+				"provider_group_id": "group-b",
+				"role":              "PROJECT_READER",
+				"projects":          []interface{}{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+			},
+			map[string]interface{}{
+				"provider_group_id": "group-a",
+				"role":              "PROJECT_ADMIN",
+				"projects":          []interface{}{},
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"id": "e9a5c2a5-6b0e-4c5a-9f7a-3e1b2c4d5e6f",
+		"group_mapping": []interface{}{
+			map[string]interface{}{
+				"provider_group_id": "group-a",
+				"role":              "PROJECT_ADMIN",
+				"projects":          []interface{}{},
+			},
+			map[string]interface{}{
+				"provider_group_id": "group-b",
+				"role":              "PROJECT_READER",
+				"projects":          []interface{}{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+			},
+		},
+	}
+
+	upgraded, err := resourceWizSAMLIdPStateUpgradeV0(ctx, rawState, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(expected, upgraded) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			utils.PrettyPrint(upgraded),
+			utils.PrettyPrint(expected),
+		)
+	}
+}
+
+func TestNormalizeGroupMappingProjectsOnPlan(t *testing.T) {
+	ctx := context.Background()
+
+	m := &config.ProviderConf{}
+	m.ProjectSlugs.Store("my-project", "e228be4f-1697-4c02-ab8c-7d3c526cb22c")
+	m.ProjectSlugs.Store("new-project", "aad6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e11")
+
+	config := func(projects []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "70bbbb01-6438-4e91-82d9-e1d46e7795f8",
+			"login_url":   "https://example.com",
+			"certificate": "7949a0d0-bb64-43e1-9af7-1c0ee0574f7a",
+			"group_mapping": []interface{}{
+				map[string]interface{}{
+					"provider_group_id": "f11fd4a4-ba73-448d-9894-8dbd4c94f48b",
+					"role":              "PROJECT_READER",
+					"projects":          projects,
+				},
+			},
+		}
+	}
+
+	r := resourceWizSAMLIdP()
+	d := schema.TestResourceDataRaw(t, r.Schema, config([]interface{}{
+		"e228be4f-1697-4c02-ab8c-7d3c526cb22c",
+		"f48d4e70-7028-4f5f-8f30-a77e139f8d38",
+	}))
+	d.SetId("e9a5c2a5-6b0e-4c5a-9f7a-3e1b2c4d5e6f")
+	state := d.State()
+
+	// a slug that resolves to the stored project id plans no change
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config([]interface{}{
+		"slug:my-project",
+		"f48d4e70-7028-4f5f-8f30-a77e139f8d38",
+	})), m)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff != nil {
+		for k, v := range diff.Attributes {
+			if strings.HasPrefix(k, "group_mapping.") {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					fmt.Sprintf("%s: %#v", k, v),
+					"no group_mapping changes",
+				)
+			}
+		}
+	}
+
+	// an added slug is planned as its project id
+	diff, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config([]interface{}{
+		"slug:my-project",
+		"slug:new-project",
+		"f48d4e70-7028-4f5f-8f30-a77e139f8d38",
+	})), m)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var planned []string
+	for k, v := range diff.Attributes {
+		if strings.HasPrefix(k, "group_mapping.") && strings.Contains(k, ".projects.") && !strings.HasSuffix(k, ".#") && !v.NewRemoved {
+			planned = append(planned, v.New)
+		}
+	}
+	sort.Strings(planned)
+
+	expected := []string{
+		"aad6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e11",
+		"e228be4f-1697-4c02-ab8c-7d3c526cb22c",
+		"f48d4e70-7028-4f5f-8f30-a77e139f8d38",
+	}
+	if !reflect.DeepEqual(planned, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			planned,
+			expected,
+		)
+	}
+}
+
+func TestGroupMappingProjectsDiff(t *testing.T) {
+	ctx := context.Background()
+
+	projects := []interface{}{
+		"00d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e01",
+		"11d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e02",
+		"22d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e03",
+		"33d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e04",
+		"44d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e05",
+		"55d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e06",
+		"66d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e07",
+		"77d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e08",
+		"88d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e09",
+		"99d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e10",
+	}
+	added := "aad6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e11"
+
+	config := func(projects []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "70bbbb01-6438-4e91-82d9-e1d46e7795f8",
+			"login_url":   "https://example.com",
+			"certificate": "7949a0d0-bb64-43e1-9af7-1c0ee0574f7a",
+			"group_mapping": []interface{}{
+				map[string]interface{}{
+					"provider_group_id": "f11fd4a4-ba73-448d-9894-8dbd4c94f48b",
+					"role":              "PROJECT_READER",
+					"projects":          projects,
+				},
+			},
+		}
+	}
+
+	r := resourceWizSAMLIdP()
+	d := schema.TestResourceDataRaw(t, r.Schema, config(projects))
+	d.SetId("e9a5c2a5-6b0e-4c5a-9f7a-3e1b2c4d5e6f")
+	state := d.State()
+
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(append(append([]interface{}{}, projects...), added))), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// only the added project is planned, the mapping and its other projects are unchanged
+	var changes []string
+	for k, v := range diff.Attributes {
+		if strings.HasSuffix(k, ".#") || v.Old == v.New {
+			continue
+		}
+		// set elements are keyed by a hash code, which is left out
+		changes = append(changes, fmt.Sprintf("%s: %q => %q", k[:strings.LastIndex(k, ".")], v.Old, v.New))
+	}
+
+	expected := []string{fmt.Sprintf("group_mapping.0.projects: \"\" => %q", added)}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			changes,
+			expected,
+		)
+	}
+}
+
+func TestDuplicateGroupMappings(t *testing.T) {
+	mapping := func(group, role string, projects ...interface{}) interface{} {
+		return map[string]interface{}{
+			"provider_group_id": group,
+			"role":              role,
+			"projects":          schema.NewSet(schema.HashString, projects),
+		}
+	}
+
+	mappings := []interface{}{
+		mapping("platform", "PROJECT_ADMIN", "ee25cc95-82b0-4543-8934-5bc655b86786", "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b"),
+		mapping("platform", "PROJECT_READER", "ee25cc95-82b0-4543-8934-5bc655b86786"),
+		mapping("platform", "PROJECT_ADMIN", "3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b", "ee25cc95-82b0-4543-8934-5bc655b86786"),
+		mapping("security", "GLOBAL_READER"),
+		mapping("security", "GLOBAL_READER"),
+		mapping("platform", "PROJECT_READER", "ee25cc95-82b0-4543-8934-5bc655b86786"),
+	}
+	known := []bool{true, true, true, true, true, false}
+
+	expected := []string{
+		"group_mapping.0 and group_mapping.2 both map group platform to role PROJECT_ADMIN with the same projects",
+		"group_mapping.3 and group_mapping.4 both map group security to role GLOBAL_READER with the same projects",
+	}
+
+	errs := duplicateGroupMappings(mappings, known)
+	if !reflect.DeepEqual(expected, errs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			errs,
+			expected,
+		)
+	}
+}
+
+func TestRawGroupMappingsDuplicates(t *testing.T) {
+	mapping := func(group, role string, projects ...string) cty.Value {
+		values := []cty.Value{}
+		for _, p := range projects {
+			values = append(values, cty.StringVal(p))
+		}
+		projectsVal := cty.SetValEmpty(cty.String)
+		if len(values) > 0 {
+			projectsVal = cty.SetVal(values)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"provider_group_id": cty.StringVal(group),
+			"role":              cty.StringVal(role),
+			"projects":          projectsVal,
+		})
+	}
+
+	// the configured blocks are compared before the plan merges or normalizes them
+	configured := cty.ListVal([]cty.Value{
+		mapping("platform", "PROJECT_ADMIN", "ee25cc95-82b0-4543-8934-5bc655b86786"),
+		cty.ObjectVal(map[string]cty.Value{
+			"provider_group_id": cty.StringVal("platform"),
+			"role":              cty.StringVal("PROJECT_ADMIN"),
+			"projects":          cty.UnknownVal(cty.Set(cty.String)),
+		}),
+		mapping("platform", "PROJECT_ADMIN", "ee25cc95-82b0-4543-8934-5bc655b86786"),
+	})
+
+	expected := []string{
+		"group_mapping.0 and group_mapping.2 both map group platform to role PROJECT_ADMIN with the same projects",
+	}
+
+	errs := duplicateGroupMappings(rawGroupMappings(configured))
+	if !reflect.DeepEqual(expected, errs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			errs,
+			expected,
+		)
+	}
+}
+
+func TestGroupMappingProjectIDs(t *testing.T) {
+	var groupMappings = []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "group-a",
+			"role":              "PROJECT_ADMIN",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+				"slug:my-project",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "group-b",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "global.admin",
+			"role":              "GLOBAL_ADMIN",
+		},
+	}
+
+	expected := []string{
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+		"ee25cc95-82b0-4543-8934-5bc655b86786",
+	}
+
+	projectIDs := groupMappingProjectIDs(groupMappings)
+	sort.Strings(projectIDs)
+
+	if !reflect.DeepEqual(expected, projectIDs) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			projectIDs,
+			expected,
+		)
+	}
+}
+
+func TestProjectReferenceDiags(t *testing.T) {
+	projects := map[string]*wiz.Project{
+		"ee25cc95-82b0-4543-8934-5bc655b86786": {
+			ID: "ee25cc95-82b0-4543-8934-5bc655b86786",
+		},
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d": {
+			ID:       "e7f6542c-81f6-43cf-af48-bdd77f09650d",
+			Archived: true,
+		},
+	}
+
+	diags := projectReferenceDiags([]string{
+		"ee25cc95-82b0-4543-8934-5bc655b86786",
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+		"0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+		"3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+	}, projects)
+
+	if len(diags) != 3 {
+		t.Fatalf("Expected 3 diagnostics, got %d: %#v", len(diags), diags)
+	}
+	if diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "e7f6542c-81f6-43cf-af48-bdd77f09650d") {
+		t.Fatalf("Expected a warning for the archived project, got: %#v", diags[0])
+	}
+	if diags[1].Severity != diag.Error || !strings.Contains(diags[1].Detail, "0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c") {
+		t.Fatalf("Expected an error for the missing project, got: %#v", diags[1])
+	}
+	if diags[2].Severity != diag.Error || !strings.Contains(diags[2].Detail, "3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f") {
+		t.Fatalf("Expected an error for the second missing project, got: %#v", diags[2])
+	}
+}
+
+func TestApplyArchivedProjectPolicy(t *testing.T) {
+	projects := []string{
+		"ee25cc95-82b0-4543-8934-5bc655b86786",
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+	}
+	archived := map[string]bool{
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d": true,
+	}
+
+	// keep sends archived projects unchanged
+	output, diags := applyArchivedProjectPolicy("keep", "engineering", projects, archived)
+	if len(diags) != 0 || !reflect.DeepEqual(output, projects) {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected:\n\n%#v\n", output, diags, projects)
+	}
+
+	// drop removes archived projects with a warning
+	output, diags = applyArchivedProjectPolicy("drop", "engineering", projects, archived)
+	expected := []string{"ee25cc95-82b0-4543-8934-5bc655b86786"}
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", output, expected)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning for the dropped project, got: %#v", diags)
+	}
+
+	// error fails for archived projects
+	_, diags = applyArchivedProjectPolicy("error", "engineering", projects, archived)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "e7f6542c-81f6-43cf-af48-bdd77f09650d") {
+		t.Fatalf("Expected an error for the archived project, got: %#v", diags)
+	}
+}
+
+func TestKeepDroppedArchivedProjects(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+				"0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+			}),
+		},
+	}
+	flattened := []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"projects": []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			},
+		},
+	}
+
+	dropped := droppedGroupMappingProjects(configured, flattened)
+	sort.Strings(dropped)
+	expectedDropped := []string{
+		"0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+	}
+	if !reflect.DeepEqual(dropped, expectedDropped) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", dropped, expectedDropped)
+	}
+
+	// only the archived project is kept, the other missing project is reported as drift
+	keepDroppedArchivedProjects(configured, flattened, map[string]bool{
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d": true,
+	})
+	expected := []interface{}{
+		"ee25cc95-82b0-4543-8934-5bc655b86786",
+		"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+	}
+	if !reflect.DeepEqual(flattened[0].(map[string]interface{})["projects"], expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", flattened[0].(map[string]interface{})["projects"], expected)
+	}
+}
+
+func TestForbiddenGlobalScopes(t *testing.T) {
+	roleScopes := []string{
+		"read:all",
+		"write:all",
+		"admin:all",
+		"write:all",
+	}
+	forbiddenScopes := []string{
+		"write:all",
+		"admin:all",
+		"admin:security_settings",
+	}
+
+	expected := []string{
+		"admin:all",
+		"write:all",
+	}
+
+	forbidden := forbiddenGlobalScopes(roleScopes, forbiddenScopes)
+	if !reflect.DeepEqual(expected, forbidden) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			forbidden,
+			expected,
+		)
+	}
+
+	forbidden = forbiddenGlobalScopes([]string{"read:all"}, forbiddenScopes)
+	if forbidden != nil {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			forbidden,
+			nil,
+		)
+	}
+}
+
+func TestUnappliedGroupMappingProjects(t *testing.T) {
+	sent := []*wiz.SAMLGroupMappingCreateInput{
+		{
+			ProviderGroupID: "platform",
+			Role:            "PROJECT_ADMIN",
+			Projects: []string{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+				"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b",
+				"9c1d7e2f-6a3b-4c8d-8e5f-0a7b3c9d2e1f",
+			},
+		},
+		{
+			ProviderGroupID: "auditors",
+			Role:            "GLOBAL_READER",
+		},
+		{
+			ProviderGroupID: "data",
+			Role:            "PROJECT_READER",
+			Projects: []string{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			},
+		},
+	}
+	stored := []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "platform",
+			"role":              "PROJECT_ADMIN",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "auditors",
+			"role":              "GLOBAL_READER",
+			"projects":          schema.NewSet(schema.HashString, []interface{}{}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "data",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			}),
+		},
+	}
+
+	diags := unappliedGroupMappingProjects(sent, stored)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "projects 9c1d7e2f-6a3b-4c8d-8e5f-0a7b3c9d2e1f, ee25cc95-82b0-4543-8934-5bc655b86786 to the mapping of group platform to role PROJECT_ADMIN") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected an error for the platform mapping\n", diags)
+	}
+
+	diags = unappliedGroupMappingProjects(sent[1:], stored)
+	if diags != nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags, nil)
+	}
+}
+
+func TestGroupMappingProjectLimitDiags(t *testing.T) {
+	settings := &config.Settings{
+		GroupMappingProjectLimit:       2,
+		GroupMappingProjectLimitPolicy: "warn",
+	}
+
+	diags := groupMappingProjectLimitDiags(settings, "platform", "PROJECT_ADMIN", 2)
+	if diags != nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags, nil)
+	}
+
+	diags = groupMappingProjectLimitDiags(settings, "platform", "PROJECT_ADMIN", 3)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "group platform to role PROJECT_ADMIN has 3 projects") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected a warning for the platform mapping\n", diags)
+	}
+
+	settings.GroupMappingProjectLimitPolicy = "error"
+	diags = groupMappingProjectLimitDiags(settings, "platform", "PROJECT_ADMIN", 3)
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("Got:\n\n%#v\n\nExpected an error for the platform mapping\n", diags)
+	}
+
+	settings.GroupMappingProjectLimit = 0
+	diags = groupMappingProjectLimitDiags(settings, "platform", "PROJECT_ADMIN", 3)
+	if diags != nil {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags, nil)
+	}
+}
+
+func TestGroupMappingProjectRemovals(t *testing.T) {
+	previous := []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+				"0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+				"3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "operations",
+			"role":              "PROJECT_MEMBER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			}),
+		},
+		map[string]interface{}{
+			"provider_group_id": "security",
+			"role":              "PROJECT_ADMIN",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			}),
+		},
+	}
+	current := []interface{}{
+		map[string]interface{}{
+			"provider_group_id": "engineering",
+			"role":              "PROJECT_READER",
+			"projects": schema.NewSet(schema.HashString, []interface{}{
+				"ee25cc95-82b0-4543-8934-5bc655b86786",
+			}),
+		},
+		// a mapping without projects applies to all projects
+		map[string]interface{}{
+			"provider_group_id": "security",
+			"role":              "PROJECT_ADMIN",
+			"projects":          schema.NewSet(schema.HashString, []interface{}{}),
+		},
+	}
+
+	removals := groupMappingProjectRemovals(previous, current)
+	expected := []groupMappingProjectRemoval{
+		{
+			ProviderGroupID: "engineering",
+			Role:            "PROJECT_READER",
+			Removed: []string{
+				"0f9a8b7c-6d5e-4f3a-9b2c-1d0e9f8a7b6c",
+				"3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+				"e7f6542c-81f6-43cf-af48-bdd77f09650d",
+			},
+			Total: 4,
+		},
+		{
+			ProviderGroupID: "operations",
+			Role:            "PROJECT_MEMBER",
+			Removed:         []string{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+			Total:           1,
+		},
+	}
+	if !reflect.DeepEqual(removals, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", removals, expected)
+	}
+
+	// no limits
+	if diags := projectRemovalDiags(removals, 0, 0); len(diags) != 0 {
+		t.Fatalf("Expected no diagnostics without limits, got: %#v", diags)
+	}
+
+	// at most 2 projects per mapping
+	diags := projectRemovalDiags(removals, 2, 0)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "removes 3 of 4 projects (75%) from the mapping of group engineering") {
+		t.Fatalf("Expected an error for the engineering mapping, got: %#v", diags)
+	}
+
+	// at most half of the projects of a mapping
+	diags = projectRemovalDiags(removals, 0, 50)
+	if len(diags) != 2 {
+		t.Fatalf("Expected an error for both mappings, got: %#v", diags)
+	}
+}
+
+func TestGroupMappingProjectRemovalsOnPlan(t *testing.T) {
+	ctx := context.Background()
+
+	config := func(confirm bool, projects ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                    "70bbbb01-6438-4e91-82d9-e1d46e7795f8",
+			"login_url":               "https://example.com",
+			"certificate":             "7949a0d0-bb64-43e1-9af7-1c0ee0574f7a",
+			"confirm_project_removal": confirm,
+			"group_mapping": []interface{}{
+				map[string]interface{}{
+					"provider_group_id": "f11fd4a4-ba73-448d-9894-8dbd4c94f48b",
+					"role":              "PROJECT_READER",
+					"projects":          projects,
+				},
+			},
+		}
+	}
+
+	r := resourceWizSAMLIdP()
+	d := schema.TestResourceDataRaw(t, r.Schema, config(false,
+		"00d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e01",
+		"11d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e02",
+		"22d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e03",
+		"33d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e04",
+	))
+	d.SetId("e9a5c2a5-6b0e-4c5a-9f7a-3e1b2c4d5e6f")
+	state := d.State()
+
+	// removing 3 of 4 projects exceeds the default limit of 50%
+	_, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(false, "00d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e01")), nil)
+	if err == nil || !strings.Contains(err.Error(), "removes 3 of 4 projects (75%)") {
+		t.Fatalf("Got:\n\n%#v\n\nExpected the plan to fail\n", err)
+	}
+
+	// confirm_project_removal allows the removal
+	_, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(true, "00d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e01")), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// removing 1 of 4 projects is within the default limits
+	_, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config(false,
+		"00d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e01",
+		"11d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e02",
+		"22d6cb4f-dc0a-4a45-8c7a-8a8e6b4d0e03",
+	)), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestGroupMappingInputOmitsUnsetProjects(t *testing.T) {
+	vars := &wiz.UpdateSAMLIdentityProviderInput{}
+	vars.ID = "saml-idp-1"
+	vars.Patch.GroupMapping = []wiz.SAMLGroupMappingUpdateInput{
+		{
+			ProviderGroupID: "engineering",
+			Role:            "PROJECT_READER",
+			Projects:        []string{"ee25cc95-82b0-4543-8934-5bc655b86786"},
+		},
+		{
+			ProviderGroupID: "security",
+			Role:            "GLOBAL_READER",
+		},
+	}
+
+	payload, err := json.Marshal(vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Patch struct {
+			GroupMapping []map[string]interface{} `json:"groupMapping"`
+		} `json:"patch"`
+	}
+	err = json.Unmarshal(payload, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := decoded.Patch.GroupMapping[0]["projects"]; !ok {
+		t.Fatalf("Expected projects for the engineering mapping, got: %s", payload)
+	}
+	if _, ok := decoded.Patch.GroupMapping[1]["projects"]; ok {
+		t.Fatalf("Expected no projects for the security mapping, got: %s", payload)
+	}
+}
+
+func TestSAMLIdPCreateSkipReadAfterWrite(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				requests = append(requests, string(body))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"data":{"createSAMLIdentityProvider":{"samlIdentityProvider":{"id":"okta"}}}}`)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL:                    "http://example.com",
+			SAMLIdPSkipReadAfterWrite: true,
+		},
+	}
+
+	d := schema.TestResourceDataRaw(
+		t,
+		resourceWizSAMLIdP().Schema,
+		map[string]interface{}{
+			"name":        "okta",
+			"login_url":   "https://example.okta.com/app/wiz/sso/saml",
+			"certificate": "certificate",
+		},
+	)
+
+	diags := resourceWizSAMLIdPCreate(ctx, d, m)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %#v", diags)
+	}
+	if d.Id() != "okta" || d.Get("name").(string) != "okta" {
+		t.Fatalf("Got:\n\n%#v %#v\n\nExpected:\n\n%#v %#v\n", d.Id(), d.Get("name"), "okta", "okta")
+	}
+	// only the mutation is sent, the identity provider is not read back
+	if len(requests) != 1 || !strings.Contains(requests[0], "createSAMLIdentityProvider") {
+		t.Fatalf("Expected only the create mutation, got: %#v", requests)
+	}
+}
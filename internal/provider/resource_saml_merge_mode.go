@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
+)
+
+func resourceWizSAMLMergeMode() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage only the group mapping merge mode (`merge_groups_mapping_by_role`) of an existing SAML identity provider. This allows the identity provider configuration to be owned outside Terraform (or by another configuration) while Terraform controls the merge behavior. Destroying this resource removes it from state and leaves the identity provider unchanged.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier for this object (same as `saml_idp_id`).",
+			},
+			"saml_idp_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The SAML identity provider identifier.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+			},
+			"merge_enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether group mappings are merged by role (`merge_groups_mapping_by_role`).",
+			},
+		},
+		CreateContext: resourceWizSAMLMergeModeCreate,
+		ReadContext:   resourceWizSAMLMergeModeRead,
+		UpdateContext: resourceWizSAMLMergeModeUpdate,
+		DeleteContext: resourceWizSAMLMergeModeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func setSAMLMergeMode(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "setSAMLMergeMode called...")
+
+	// define the graphql query
+	query := `mutation UpdateSAMLIdentityProvider($input: UpdateSAMLIdentityProviderInput!) {
+	  updateSAMLIdentityProvider(
+	    input: $input
+	  ) {
+	    samlIdentityProvider {
+	      id
+	      mergeGroupsMappingByRole
+	    }
+	  }
+	}`
+
+	// populate the graphql variables
+	// only the merge mode is patched, all other identity provider settings are left untouched
+	vars := &wiz.UpdateSAMLIdentityProviderMergeMode{}
+	vars.ID = d.Get("saml_idp_id").(string)
+	vars.Patch.MergeGroupsMappingByRole = utils.ConvertBoolToPointer(d.Get("merge_enabled").(bool))
+
+	// process the request
+	data := &UpdateSAMLIdentityProvider{}
+	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "saml_merge_mode", "update")
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return diags
+}
+
+func resourceWizSAMLMergeModeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizSAMLMergeModeCreate called...")
+
+	diags = setSAMLMergeMode(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	// set the id
+	d.SetId(d.Get("saml_idp_id").(string))
+
+	return resourceWizSAMLMergeModeRead(ctx, d, m)
+}
+
+func resourceWizSAMLMergeModeRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizSAMLMergeModeRead called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	// define the graphql query
+	query := `query samlIdentityProvider ($id: ID!){
+	    samlIdentityProvider (
+	        id: $id
+	    ) {
+	        id
+	        mergeGroupsMappingByRole
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = d.Id()
+
+	// process the request
+	data := &ReadSAMLIdentityProviderPayload{}
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "saml_merge_mode", func() bool { return data.SAMLIdentityProvider.ID != "" })
+	diags = append(diags, requestDiags...)
+	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.SAMLIdentityProvider.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
+		return diags
+	}
+
+	// set the resource parameters
+	err := d.Set("saml_idp_id", data.SAMLIdentityProvider.ID)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("merge_enabled", data.SAMLIdentityProvider.MergeGroupsMappingByRole)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceWizSAMLMergeModeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizSAMLMergeModeUpdate called...")
+
+	// check the id
+	if d.Id() == "" {
+		return nil
+	}
+
+	diags = setSAMLMergeMode(ctx, d, m)
+	if len(diags) > 0 {
+		return diags
+	}
+
+	return resourceWizSAMLMergeModeRead(ctx, d, m)
+}
+
+func resourceWizSAMLMergeModeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "resourceWizSAMLMergeModeDelete called...")
+
+	// the identity provider is owned elsewhere, so it is left in its current state
+	d.SetId("")
+
+	return diags
+}
@@ -39,6 +39,15 @@ func resourceWizSecurityFramework() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"clone_from_framework_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Identifier of an existing security framework (e.g. a built-in CIS benchmark) whose categories and subcategories are copied into the new framework at create. A `category` with the same name as a copied category replaces it. Copied categories that are not configured are kept in Wiz but not tracked in state. Only used at create, changes are ignored afterwards.",
+				ValidateDiagFunc: utils.ValidateUUIDOrIdentifier,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
 			"category": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -165,6 +174,116 @@ func getSecuritySubCategories(ctx context.Context, set *schema.Set) []wiz.Securi
 	return mySecuritySubCategories
 }
 
+// readSecurityFrameworkCategories returns the categories of a security framework as inputs, keeping their identifiers
+func readSecurityFrameworkCategories(ctx context.Context, m interface{}, id string) ([]wiz.SecurityCategoryInput, diag.Diagnostics) {
+	tflog.Info(ctx, "readSecurityFrameworkCategories called...")
+
+	// define the graphql query
+	query := `query securityFramework  (
+	    $id: ID!
+	){
+	    securityFramework(
+	        id: $id
+	    ) {
+	        id
+	        categories {
+	            id
+	            name
+	            description
+	            subCategories {
+	                id
+	                title
+	                description
+	            }
+	        }
+	    }
+	}`
+
+	// populate the graphql variables
+	vars := &internal.QueryVariables{}
+	vars.ID = id
+
+	// process the request
+	data := &ReadSecurityFrameworkPayload{}
+	diags := client.ProcessRequest(ctx, m, vars, data, query, "security_framework", "read")
+	if len(diags) > 0 {
+		return nil, diags
+	}
+	if data.SecurityFramework.ID == "" {
+		return nil, append(diags, diag.Errorf("security framework %s not found", id)...)
+	}
+
+	var output []wiz.SecurityCategoryInput
+	for _, c := range data.SecurityFramework.Categories {
+		category := wiz.SecurityCategoryInput{
+			ID:            c.ID,
+			Name:          c.Name,
+			Description:   c.Description,
+			SubCategories: make([]wiz.SecuritySubCategoryInput, 0, len(c.SubCategories)),
+		}
+		for _, sc := range c.SubCategories {
+			category.SubCategories = append(category.SubCategories, wiz.SecuritySubCategoryInput{
+				ID:          sc.ID,
+				Title:       sc.Title,
+				Description: sc.Description,
+			})
+		}
+		output = append(output, category)
+	}
+	return output, diags
+}
+
+// withoutSecurityCategoryIDs clears the category and subcategory identifiers so the categories are created as new
+func withoutSecurityCategoryIDs(categories []wiz.SecurityCategoryInput) []wiz.SecurityCategoryInput {
+	for i := range categories {
+		categories[i].ID = ""
+		for j := range categories[i].SubCategories {
+			categories[i].SubCategories[j].ID = ""
+		}
+	}
+	return categories
+}
+
+// mergeSecurityCategories returns the base categories with the categories of the same name replaced by the overrides
+// overrides with new names are appended in order
+func mergeSecurityCategories(base, overrides []wiz.SecurityCategoryInput) []wiz.SecurityCategoryInput {
+	overridden := make(map[string]bool)
+	for _, c := range overrides {
+		overridden[c.Name] = true
+	}
+	var output []wiz.SecurityCategoryInput
+	for _, c := range base {
+		if !overridden[c.Name] {
+			output = append(output, c)
+		}
+	}
+	return append(output, overrides...)
+}
+
+// configuredSecurityCategories returns the flattened categories whose names are configured
+func configuredSecurityCategories(flattened []interface{}, configured []wiz.SecurityCategoryInput) []interface{} {
+	names := make(map[string]bool)
+	for _, c := range configured {
+		names[c.Name] = true
+	}
+	var output = make([]interface{}, 0, len(configured))
+	for _, c := range flattened {
+		if names[c.(map[string]interface{})["name"].(string)] {
+			output = append(output, c)
+		}
+	}
+	return output
+}
+
+// getSecurityCategoryNames returns the names of the categories in the old value of a category change
+func getSecurityCategoryNames(oldValue, _ interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, c := range oldValue.(*schema.Set).List() {
+		names[c.(map[string]interface{})["name"].(string)] = true
+	}
+	return names
+}
+
 // CreateSecurityFramework struct
 type CreateSecurityFramework struct {
 	CreateSecurityFramework wiz.CreateSecurityFrameworkPayload `json:"createSecurityFramework"`
@@ -193,6 +312,16 @@ func resourceWizSecurityFrameworkCreate(ctx context.Context, d *schema.ResourceD
 	vars.Enabled = utils.ConvertBoolToPointer(d.Get("enabled").(bool))
 	vars.Categories = getSecurityCategories(ctx, d)
 
+	// seed the categories from the cloned framework, configured categories replace copied ones with the same name
+	if sourceID, ok := d.GetOk("clone_from_framework_id"); ok {
+		sourceCategories, sourceDiags := readSecurityFrameworkCategories(ctx, m, sourceID.(string))
+		diags = append(diags, sourceDiags...)
+		if len(diags) > 0 {
+			return diags
+		}
+		vars.Categories = mergeSecurityCategories(withoutSecurityCategoryIDs(sourceCategories), vars.Categories)
+	}
+
 	// process the request
 	data := &CreateSecurityFramework{}
 	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "security_framework", "create")
@@ -296,7 +425,7 @@ func resourceWizSecurityFrameworkRead(ctx context.Context, d *schema.ResourceDat
 	// this query returns http 200 with a payload that contains errors and a null data body
 	// error message: oops! an internal error has occurred. for reference purposes, this is your request id
 	data := &ReadSecurityFrameworkPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "security_framework", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "security_framework", func() bool { return data.SecurityFramework.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
 		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
@@ -324,6 +453,9 @@ func resourceWizSecurityFrameworkRead(ctx context.Context, d *schema.ResourceDat
 		return append(diags, diag.FromErr(err)...)
 	}
 	securityCategories := flattenSecurityCategories(ctx, data.SecurityFramework)
+	if _, ok := d.GetOk("clone_from_framework_id"); ok {
+		securityCategories = configuredSecurityCategories(securityCategories, getSecurityCategories(ctx, d))
+	}
 	if err := d.Set("category", securityCategories); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
@@ -375,6 +507,23 @@ func resourceWizSecurityFrameworkUpdate(ctx context.Context, d *schema.ResourceD
 	// if security catetories are altered, we must send the all security categories
 	if d.HasChange("category") {
 		vars.Patch.Categories = getSecurityCategories(ctx, d)
+
+		// keep the untracked categories copied from a cloned framework
+		if _, ok := d.GetOk("clone_from_framework_id"); ok {
+			currentCategories, currentDiags := readSecurityFrameworkCategories(ctx, m, d.Id())
+			diags = append(diags, currentDiags...)
+			if len(diags) > 0 {
+				return diags
+			}
+			oldCategories := getSecurityCategoryNames(d.GetChange("category"))
+			var untracked []wiz.SecurityCategoryInput
+			for _, c := range currentCategories {
+				if !oldCategories[c.Name] {
+					untracked = append(untracked, c)
+				}
+			}
+			vars.Patch.Categories = mergeSecurityCategories(untracked, vars.Patch.Categories)
+		}
 	}
 
 	// process the request
@@ -243,3 +243,88 @@ func TestFlattenSecuritySubCategories(t *testing.T) {
 		)
 	}
 }
+
+func TestMergeSecurityCategories(t *testing.T) {
+	var base = withoutSecurityCategoryIDs([]wiz.SecurityCategoryInput{
+		{
+			ID:   "2f0c7b3a-8f3e-4b0e-9a63-0e4d5bb1f6a1",
+			Name: "1 Identity and Access Management",
+			SubCategories: []wiz.SecuritySubCategoryInput{
+				{
+					ID:    "0c9a6a6e-58a2-4b5e-9b9f-7f4a3a6c1d10",
+					Title: "1.1 Maintain current contact details",
+				},
+			},
+		},
+		{
+			ID:   "a1e8b0b4-91cf-4bb5-8ad8-3d0d6fef2b55",
+			Name: "2 Storage",
+		},
+	})
+
+	var overrides = []wiz.SecurityCategoryInput{
+		{
+			Name:        "2 Storage",
+			Description: "Storage controls adapted for our accounts",
+		},
+		{
+			Name: "3 Internal",
+		},
+	}
+
+	var expected = []wiz.SecurityCategoryInput{
+		{
+			Name: "1 Identity and Access Management",
+			SubCategories: []wiz.SecuritySubCategoryInput{
+				{
+					Title: "1.1 Maintain current contact details",
+				},
+			},
+		},
+		{
+			Name:        "2 Storage",
+			Description: "Storage controls adapted for our accounts",
+		},
+		{
+			Name: "3 Internal",
+		},
+	}
+
+	merged := mergeSecurityCategories(base, overrides)
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			merged,
+			expected,
+		)
+	}
+}
+
+func TestConfiguredSecurityCategories(t *testing.T) {
+	var flattened = []interface{}{
+		map[string]interface{}{
+			"id":   "2f0c7b3a-8f3e-4b0e-9a63-0e4d5bb1f6a1",
+			"name": "1 Identity and Access Management",
+		},
+		map[string]interface{}{
+			"id":   "a1e8b0b4-91cf-4bb5-8ad8-3d0d6fef2b55",
+			"name": "2 Storage",
+		},
+	}
+
+	var expected = []interface{}{
+		map[string]interface{}{
+			"id":   "a1e8b0b4-91cf-4bb5-8ad8-3d0d6fef2b55",
+			"name": "2 Storage",
+		},
+	}
+
+	configured := configuredSecurityCategories(flattened, []wiz.SecurityCategoryInput{{Name: "2 Storage"}})
+	if !reflect.DeepEqual(configured, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			configured,
+			expected,
+		)
+	}
+}
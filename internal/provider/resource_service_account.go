@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -83,7 +84,7 @@ func resourceWizServiceAccount() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "Project ID assignments, optional with THIRD_PARTY (GraphQL API type)",
+				Description: "Project ID assignments, optional with THIRD_PARTY (GraphQL API type). Other service account types are global and reject project assignments at plan time.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateDiagFunc: validation.ToDiagFunc(
@@ -103,7 +104,9 @@ func resourceWizServiceAccount() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
+		CustomizeDiff: validateServiceAccountScope,
 		CreateContext: resourceWizServiceAccountCreate,
 		ReadContext:   resourceWizServiceAccountRead,
 		UpdateContext: resourceWizServiceAccountUpdate,
@@ -114,6 +117,27 @@ func resourceWizServiceAccount() *schema.Resource {
 	}
 }
 
+// validateServiceAccountScope rejects project assignments for service account types that are always global
+// without this check the projects are silently dropped and the account is created with tenant-wide access
+func validateServiceAccountScope(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	t := diff.Get("type").(string)
+	if t == "THIRD_PARTY" {
+		return nil
+	}
+	if projects, ok := diff.GetOk("assigned_projects"); ok && len(projects.([]interface{})) > 0 {
+		return fmt.Errorf("'assigned_projects' can only be set for THIRD_PARTY service accounts, %s service accounts are global", t)
+	}
+	return nil
+}
+
+// keepConfiguredOrder returns the configured values when read holds the same values in another order, otherwise read
+func keepConfiguredOrder(configured []string, read []string) []string {
+	if len(configured) != len(read) || len(utils.Missing(configured, read)) > 0 || len(utils.Missing(read, configured)) > 0 {
+		return read
+	}
+	return configured
+}
+
 // CreateServiceAccount struct
 type CreateServiceAccount struct {
 	CreateServiceAccount wiz.CreateServiceAccountPayload `json:"createServiceAccount"`
@@ -156,7 +180,7 @@ func resourceWizServiceAccountCreate(ctx context.Context, d *schema.ResourceData
 	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "service_account", "create")
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
-		return diags
+		return serviceAccountQuotaDiags(ctx, m, diags)
 	}
 
 	// set the id and computed values
@@ -169,6 +193,48 @@ func resourceWizServiceAccountCreate(ctx context.Context, d *schema.ResourceData
 	return resourceWizServiceAccountRead(ctx, d, m)
 }
 
+// serviceAccountQuotaErrorCode is the api error code returned when the tenant has reached its service account limit
+const serviceAccountQuotaErrorCode = "QUOTA_EXCEEDED"
+
+// serviceAccountQuotaDiags replaces a quota exceeded error with a diagnostic explaining the limit and the current count
+// the count is left out when it cannot be read
+func serviceAccountQuotaDiags(ctx context.Context, m interface{}, diags diag.Diagnostics) diag.Diagnostics {
+	for i, e := range diags {
+		if e.Severity != diag.Error || !slices.Contains(client.ErrorCodes(e), serviceAccountQuotaErrorCode) {
+			continue
+		}
+		count := ""
+		if total, countDiags := countServiceAccounts(ctx, m); len(countDiags) == 0 {
+			count = fmt.Sprintf(" The tenant currently has %d service accounts.", total)
+		}
+		diags[i] = diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Service account limit reached",
+			Detail:   fmt.Sprintf("Wiz limits the number of service accounts in a tenant and the limit has been reached.%s Delete unused service accounts, or ask Wiz support to raise the limit.\n\n%s", count, e.Detail),
+		}
+	}
+	return diags
+}
+
+// countServiceAccounts returns the number of service accounts in the tenant
+func countServiceAccounts(ctx context.Context, m interface{}) (int, diag.Diagnostics) {
+	tflog.Info(ctx, "countServiceAccounts called...")
+
+	// define the graphql query
+	query := `query serviceAccountCount {
+	    serviceAccounts(
+	        first: 0
+	    ) {
+	        totalCount
+	    }
+	}`
+
+	// process the request
+	data := &ReadServiceAccounts{}
+	diags := client.ProcessRequest(ctx, m, map[string]interface{}{}, data, query, "service_account", "read")
+	return data.ServiceAccounts.TotalCount, diags
+}
+
 // ReadServiceAccountPayload struct -- updates
 type ReadServiceAccountPayload struct {
 	ServiceAccount wiz.ServiceAccount `json:"serviceAccount,omitempty"`
@@ -209,9 +275,17 @@ func resourceWizServiceAccountRead(ctx context.Context, d *schema.ResourceData,
 
 	// process the request
 	data := &ReadServiceAccountPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "service_account", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "service_account", func() bool { return data.ServiceAccount.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.ServiceAccount.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
 		return diags
 	}
 
@@ -227,6 +301,15 @@ func resourceWizServiceAccountRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
+	var assignedProjects = make([]string, 0, 0)
+	for _, b := range data.ServiceAccount.AssignedProjects {
+		assignedProjects = append(assignedProjects, b.ID)
+	}
+	// assigned_projects forces a new service account, so a reordered response must not be reported as a change
+	err = d.Set("assigned_projects", keepConfiguredOrder(utils.ConvertListToString(d.Get("assigned_projects").([]interface{})), assignedProjects))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("last_rotated_at", data.ServiceAccount.LastRotatedAt)
 	if err != nil {
 		return append(diags, diag.FromErr(err)...)
@@ -277,6 +360,12 @@ func resourceWizServiceAccountDelete(ctx context.Context, d *schema.ResourceData
 		return nil
 	}
 
+	// refuse to destroy protected resources
+	diags = deletionProtectionDiags(d, "wiz_service_account")
+	if len(diags) > 0 {
+		return diags
+	}
+
 	// define the graphql query
 	query := `mutation DeleteServiceAccount (
 	    $input: DeleteServiceAccountInput!
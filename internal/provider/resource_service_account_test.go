@@ -1,5 +1,46 @@
 package provider
 
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKeepConfiguredOrder(t *testing.T) {
+	configured := []string{
+		"2dc9a5ee-b52e-41a2-a13f-75c57d466acf",
+		"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab",
+	}
+
+	var tests = []struct {
+		read     []string
+		expected []string
+	}{
+		{
+			read:     []string{"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab", "2dc9a5ee-b52e-41a2-a13f-75c57d466acf"},
+			expected: configured,
+		},
+		{
+			read:     []string{"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab"},
+			expected: []string{"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab"},
+		},
+		{
+			read:     []string{"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab", "0c7e2f51-4a8b-4d3e-9f6a-1b2c3d4e5f60"},
+			expected: []string{"bc0dc093-e74e-4eea-9734-e3e5cfe1ecab", "0c7e2f51-4a8b-4d3e-9f6a-1b2c3d4e5f60"},
+		},
+	}
+
+	for _, tc := range tests {
+		result := keepConfiguredOrder(configured, tc.read)
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Fatalf(
+				"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+				result,
+				tc.expected,
+			)
+		}
+	}
+}
+
 /*
 import (
 	"testing"
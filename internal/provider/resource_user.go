@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +12,7 @@ import (
 
 	"wiz.io/hashicorp/terraform-provider-wiz/internal"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/utils"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
@@ -56,7 +58,9 @@ func resourceWizUser() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
+		CustomizeDiff: validateUserScopesOnPlan,
 		CreateContext: resourceWizUserCreate,
 		ReadContext:   resourceWizUserRead,
 		UpdateContext: resourceWizUserUpdate,
@@ -67,6 +71,32 @@ func resourceWizUser() *schema.Resource {
 	}
 }
 
+// validateUserScopesOnPlan fails the plan when a user without projects is assigned a role with a scope listed in forbidden_scope_project_combos
+func validateUserScopesOnPlan(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	conf, ok := m.(*config.ProviderConf)
+	if !ok || conf.Settings == nil || len(conf.Settings.ForbiddenScopeProjectCombos) == 0 {
+		return nil
+	}
+	if !d.HasChanges("role", "assigned_project_ids") || !d.NewValueKnown("role") || !d.NewValueKnown("assigned_project_ids") {
+		return nil
+	}
+	if len(d.Get("assigned_project_ids").([]interface{})) > 0 {
+		return nil
+	}
+
+	roleScopes, diags := readRoleScopes(ctx, m)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read role scopes: %s", diags[0].Summary)
+	}
+
+	role := d.Get("role").(string)
+	forbidden := forbiddenGlobalScopes(roleScopes[role], conf.Settings.ForbiddenScopeProjectCombos)
+	if len(forbidden) > 0 {
+		return fmt.Errorf("user %s is assigned role %s on all projects, which includes forbidden scopes: %s", d.Get("email"), role, strings.Join(forbidden, ", "))
+	}
+	return nil
+}
+
 // CreateUser struct
 type CreateUser struct {
 	CreateUser wiz.CreateUserPayload `json:"createUser"`
@@ -157,9 +187,17 @@ func resourceWizUserRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	// process the request
 	data := &ReadUserPayload{}
-	requestDiags := client.ProcessRequest(ctx, m, vars, data, query, "user", "read")
+	requestDiags := client.ReadWithConsistencyRetry(ctx, m, vars, data, query, "user", func() bool { return data.User.ID != "" })
 	diags = append(diags, requestDiags...)
 	if len(diags) > 0 {
+		tflog.Info(ctx, "Error from API call, checking if resource was deleted outside Terraform.")
+		if data.User.ID == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Response: (%T) %s", data, utils.PrettyPrint(data)))
+			tflog.Info(ctx, "Resource not found, marking as new.")
+			d.SetId("")
+			d.MarkNewResource()
+			return nil
+		}
 		return diags
 	}
 
@@ -200,6 +238,11 @@ func resourceWizUserUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return nil
 	}
 
+	// deletion_protection is only kept in state
+	if !d.HasChangeExcept("deletion_protection") {
+		return resourceWizUserRead(ctx, d, m)
+	}
+
 	// define the graphql query
 	query := `mutation UpdateUser($input: UpdateUserInput!) {
 	    updateUser(input: $input) {
@@ -250,6 +293,12 @@ func resourceWizUserDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return nil
 	}
 
+	// refuse to destroy protected resources
+	diags = deletionProtectionDiags(d, "wiz_user")
+	if len(diags) > 0 {
+		return diags
+	}
+
 	// define the graphql query
 	query := `mutation DeleteUser (
 	    $input: DeleteUserInput!
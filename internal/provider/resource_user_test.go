@@ -1,10 +1,17 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net/http"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/client"
+	"wiz.io/hashicorp/terraform-provider-wiz/internal/config"
 	"wiz.io/hashicorp/terraform-provider-wiz/internal/wiz"
 )
 
@@ -35,3 +42,50 @@ func TestFlattenAssignedProjectIDs(t *testing.T) {
 		)
 	}
 }
+
+func TestUserReadConsistencyRetry(t *testing.T) {
+	ctx := context.Background()
+	client.ConsistencyRetryWait = 0
+
+	// a user that was just created is not visible to the first read
+	requests := 0
+	m := &config.ProviderConf{
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requests++
+				response := `{"data":{"user":null},"errors":[{"message":"not found","extensions":{"code":"NOT_FOUND"}}]}`
+				if requests > 1 {
+					response = `{"data":{"user":{"id":"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b","name":"jane","email":"jane@example.com","effectiveRole":{"id":"GLOBAL_READER"}}}}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(response)),
+					Header:     make(http.Header),
+				}, nil
+			}),
+		},
+		Settings: &config.Settings{
+			WizURL:                 "http://example.com",
+			ReadConsistencyRetries: 2,
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceWizUser().Schema, map[string]interface{}{
+		"name":  "jane",
+		"email": "jane@example.com",
+		"role":  "GLOBAL_READER",
+	})
+	d.SetId("3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b")
+
+	diags := resourceWizUserRead(ctx, d, m)
+	if diags.HasError() {
+		t.Fatalf("Got:\n\n%#v\n\nExpected no errors\n", diags)
+	}
+	if d.Id() == "" || requests != 2 {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			[]interface{}{d.Id(), requests},
+			[]interface{}{"3f2b8a6c-1d4e-4f5a-9b7c-2e8d6a4f1c3b", 2},
+		)
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ErrorCodeAlreadyExists identifies errors for objects that already exist in Wiz and should be imported instead of created
+// the code is part of the provider's interface for automation: it is stable and must not be changed
+const ErrorCodeAlreadyExists = "WIZ_ALREADY_EXISTS"
+
+// errorCodePrefix and importIDPrefix start the machine readable lines of a diagnostic detail
+const (
+	errorCodePrefix = "Error code: "
+	importIDPrefix  = "Import ID: "
+)
+
+// AlreadyExistsDiagnostic returns an error for an object that already exists in Wiz
+// the summary is the human message, the detail carries the stable error code and the id to pass to terraform import
+func AlreadyExistsDiagnostic(summary string, importID string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail: fmt.Sprintf(
+			"The object already exists in Wiz and can be imported with terraform import.\n%s%s\n%s%s",
+			errorCodePrefix, ErrorCodeAlreadyExists,
+			importIDPrefix, importID,
+		),
+	}
+}
+
+// IsAlreadyExists reports whether d is an already exists error, and returns the import id it carries
+func IsAlreadyExists(d diag.Diagnostic) (importID string, ok bool) {
+	if d.Severity != diag.Error {
+		return "", false
+	}
+	for _, line := range strings.Split(d.Detail, "\n") {
+		switch {
+		case line == errorCodePrefix+ErrorCodeAlreadyExists:
+			ok = true
+		case strings.HasPrefix(line, importIDPrefix):
+			importID = strings.TrimPrefix(line, importIDPrefix)
+		}
+	}
+	if !ok {
+		return "", false
+	}
+	return importID, true
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAlreadyExistsDiagnostic(t *testing.T) {
+	summary := "cloud account 2c1f0e8d-3b4a-4c5d-9e6f-7a8b9c0d1e2f is already linked to project 5d6e7f80-1a2b-4c3d-8e9f-0a1b2c3d4e5f"
+	importID := "link|5d6e7f80-1a2b-4c3d-8e9f-0a1b2c3d4e5f|2c1f0e8d-3b4a-4c5d-9e6f-7a8b9c0d1e2f"
+
+	d := AlreadyExistsDiagnostic(summary, importID)
+	if d.Summary != summary {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", d.Summary, summary)
+	}
+
+	id, ok := IsAlreadyExists(d)
+	if !ok || id != importID {
+		t.Fatalf("Got:\n\n%#v %t\n\nExpected:\n\n%#v %t\n", id, ok, importID, true)
+	}
+
+	// other errors are not recognized
+	_, ok = IsAlreadyExists(diag.Errorf("%s", summary)[0])
+	if ok {
+		t.Fatalf("Expected a plain error not to be recognized as already exists")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 )
 
 // PrettyPrint prints a struct in formatted json
@@ -13,14 +14,33 @@ func PrettyPrint(i interface{}) string {
 }
 
 // ConvertListToString converts schema.TypeList to a slice of strings
+// nil and non-string elements, e.g. from a partially unknown list, are skipped; use ConvertListToStringWithError to report them
 func ConvertListToString(input []interface{}) []string {
 	strings := make([]string, 0)
 	for _, b := range input {
-		strings = append(strings, b.(string))
+		if v, ok := b.(string); ok {
+			strings = append(strings, v)
+		}
 	}
 	return strings
 }
 
+// ConvertListToStringWithError converts schema.TypeList to a slice of strings, and returns an error for the first nil or non-string element
+func ConvertListToStringWithError(input []interface{}) ([]string, error) {
+	strings := make([]string, 0)
+	for i, b := range input {
+		switch v := b.(type) {
+		case string:
+			strings = append(strings, v)
+		case nil:
+			return nil, fmt.Errorf("element %d is null", i)
+		default:
+			return nil, fmt.Errorf("element %d is %T, expected string", i, b)
+		}
+	}
+	return strings, nil
+}
+
 // ConvertBoolToPointer converts a bool to a pointer to bool
 func ConvertBoolToPointer(in bool) *bool {
 	t := new(bool)
@@ -99,3 +119,53 @@ func RemoveNullAndEmptyValues(m map[string]interface{}, depth int) {
 		}
 	}
 }
+
+// ChunkStrings splits a slice of strings into consecutive chunks of at most size elements
+func ChunkStrings(input []string, size int) [][]string {
+	var chunks [][]string
+	if size <= 0 {
+		return append(chunks, input)
+	}
+	for size < len(input) {
+		input, chunks = input[size:], append(chunks, input[0:size:size])
+	}
+	if len(input) > 0 {
+		chunks = append(chunks, input)
+	}
+	return chunks
+}
+
+// NormalizeJSON re-encodes a JSON document compactly with sorted object keys so equivalent documents compare equal
+func NormalizeJSON(input string) (string, error) {
+	var v interface{}
+	err := json.Unmarshal([]byte(input), &v)
+	if err != nil {
+		return "", err
+	}
+	output, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// SortFlattenedList sorts flattened objects by name then id, or by id only when orderBy is "id"
+// the sort is stable so objects that compare equal keep the order returned by the api
+func SortFlattenedList(items []interface{}, orderBy string) {
+	keys := []string{"name", "id"}
+	if orderBy == "id" {
+		keys = []string{"id"}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, _ := items[i].(map[string]interface{})
+		b, _ := items[j].(map[string]interface{})
+		for _, k := range keys {
+			av, _ := a[k].(string)
+			bv, _ := b[k].(string)
+			if av != bv {
+				return av < bv
+			}
+		}
+		return false
+	})
+}
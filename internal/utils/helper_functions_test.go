@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	expected := `{"select":true,"type":["VIRTUAL_MACHINE"],"where":{"name":{"EQUALS":["test"]}}}`
+
+	result, err := NormalizeJSON(`{
+  "type": ["VIRTUAL_MACHINE"],
+  "where": {"name": {"EQUALS": ["test"]}},
+  "select": true
+}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if result != expected {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+
+	_, err = NormalizeJSON(`{"type":`)
+	if err == nil {
+		t.Fatalf("Expected an error for invalid JSON")
+	}
+}
+
+func TestSortFlattenedList(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"id": "3", "name": "b"},
+		map[string]interface{}{"id": "2", "name": "a"},
+		map[string]interface{}{"id": "1", "name": "b"},
+	}
+
+	SortFlattenedList(items, "name")
+	expected := []interface{}{
+		map[string]interface{}{"id": "2", "name": "a"},
+		map[string]interface{}{"id": "1", "name": "b"},
+		map[string]interface{}{"id": "3", "name": "b"},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", items, expected)
+	}
+
+	SortFlattenedList(items, "id")
+	expected = []interface{}{
+		map[string]interface{}{"id": "1", "name": "b"},
+		map[string]interface{}{"id": "2", "name": "a"},
+		map[string]interface{}{"id": "3", "name": "b"},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", items, expected)
+	}
+}
+
+func TestConvertListToString(t *testing.T) {
+	var tests = []struct {
+		input       []interface{}
+		expected    []string
+		expectedErr string
+	}{
+		{
+			input:    nil,
+			expected: []string{},
+		},
+		{
+			input:       []interface{}{nil},
+			expected:    []string{},
+			expectedErr: "element 0 is null",
+		},
+		{
+			input:    []interface{}{"a", "", "b"},
+			expected: []string{"a", "", "b"},
+		},
+		{
+			input:       []interface{}{"a", 1, nil, "b", true},
+			expected:    []string{"a", "b"},
+			expectedErr: "element 1 is int, expected string",
+		},
+	}
+
+	for _, tc := range tests {
+		result := ConvertListToString(tc.input)
+		if !reflect.DeepEqual(tc.expected, result) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, tc.expected)
+		}
+
+		result, err := ConvertListToStringWithError(tc.input)
+		if tc.expectedErr == "" {
+			if err != nil || !reflect.DeepEqual(tc.expected, result) {
+				t.Fatalf("Got:\n\n%#v %#v\n\nExpected:\n\n%#v\n", result, err, tc.expected)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.expectedErr || result != nil {
+			t.Fatalf("Got:\n\n%#v %#v\n\nExpected:\n\n%#v\n", result, err, tc.expectedErr)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync/atomic"
+	"time"
+)
+
+// RedactedValue replaces sensitive values in http dumps
+const RedactedValue = "[REDACTED]"
+
+var (
+	// authorization headers, and headers whose name includes auth, cookie, key, password, secret or token
+	redactHeaderPattern = regexp.MustCompile(`(?im)^([a-z0-9-]*(?:auth|cookie|key|password|secret|token)[a-z0-9-]*:[ \t]*).*$`)
+	// json fields whose name includes secret, password, token or key material
+	redactJSONPattern = regexp.MustCompile(`(?i)("[^"]*(?:secret|password|token|private_?key|api_?key)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// form encoded fields used by the authentication request
+	redactFormPattern = regexp.MustCompile(`(?i)((?:^|[&\s])(?:client_secret|password|access_token|refresh_token)=)[^&\s]*`)
+	// string literals in graphql queries, values are normally passed as variables
+	redactGraphQLStringPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+	// httpDumpSequence orders dump files written within the same timestamp
+	httpDumpSequence uint64
+)
+
+// RedactHTTPDump masks credentials and secrets in an http request or response dump
+// all http dumps must pass through this function before being logged or written to disk
+func RedactHTTPDump(dump []byte) []byte {
+	dump = redactHeaderPattern.ReplaceAll(dump, []byte("${1}"+RedactedValue))
+	dump = redactJSONPattern.ReplaceAll(dump, []byte(`${1}"`+RedactedValue+`"`))
+	dump = redactFormPattern.ReplaceAll(dump, []byte("${1}"+RedactedValue))
+	return dump
+}
+
+// RedactGraphQLQuery masks the string literals of a graphql query, which may hold inline values
+func RedactGraphQLQuery(query string) string {
+	return redactGraphQLStringPattern.ReplaceAllString(query, `"`+RedactedValue+`"`)
+}
+
+// WriteHTTPDump writes a redacted http dump to a timestamped file in dir
+// name describes the dump, e.g. project-read-request
+func WriteHTTPDump(dir, name string, dump []byte) (string, error) {
+	seq := atomic.AddUint64(&httpDumpSequence, 1)
+	filename := filepath.Join(dir, fmt.Sprintf("%s-%06d-%s.txt", time.Now().UTC().Format("20060102T150405.000000000Z"), seq, name))
+	err := os.WriteFile(filename, RedactHTTPDump(dump), 0600)
+	if err != nil {
+		return "", err
+	}
+	return filename, nil
+}
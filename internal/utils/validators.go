@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// uuidPattern matches a canonical UUID
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// uuidLikePattern matches values made only of hex digits and dashes, with at least one dash, that look like an attempt at a UUID
+var uuidLikePattern = regexp.MustCompile(`^\s*[0-9a-fA-F]{4,}(-[0-9a-fA-F]*)+\s*$`)
+
+// httpHeaderNamePattern matches a valid http header name (an RFC 7230 token)
+var httpHeaderNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// reservedHTTPHeaders are hop-by-hop headers, which proxies do not forward, and headers set by the provider itself
+var reservedHTTPHeaders = []string{
+	"Authorization",
+	"Connection",
+	"Content-Length",
+	"Content-Type",
+	"Host",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+	"User-Agent",
+	"X-Wiz-Tenant",
+}
+
+// IsUUID reports whether v is a canonical UUID
+func IsUUID(v string) bool {
+	return uuidPattern.MatchString(v)
+}
+
+// ValidateUUID is a SchemaValidateDiagFunc that requires the value to be a canonical UUID
+func ValidateUUID(i interface{}, path cty.Path) diag.Diagnostics {
+	return validateUUID(i, path, false)
+}
+
+// ValidateUUIDOrIdentifier is a SchemaValidateDiagFunc for identifiers that are not always UUIDs (e.g. SAML provider group IDs)
+// any identifier is accepted, except values that look like a UUID but are malformed, such as a truncated copy-paste
+func ValidateUUIDOrIdentifier(i interface{}, path cty.Path) diag.Diagnostics {
+	return validateUUID(i, path, true)
+}
+
+func validateUUID(i interface{}, path cty.Path, allowIdentifier bool) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Expected a string",
+			Detail:        fmt.Sprintf("Expected type of %s to be string, got %T.", attributeName(path), i),
+			AttributePath: path,
+		}}
+	}
+	if IsUUID(v) {
+		return nil
+	}
+	if allowIdentifier && v != "" && !uuidLikePattern.MatchString(v) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Invalid UUID",
+		Detail:        fmt.Sprintf("Expected %s to be a UUID (e.g. 0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d), got %q.", attributeName(path), v),
+		AttributePath: path,
+	}}
+}
+
+// attributeName returns the name of the attribute at the end of path, for use in messages
+func attributeName(path cty.Path) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if step, ok := path[i].(cty.GetAttrStep); ok {
+			return fmt.Sprintf("%q", step.Name)
+		}
+	}
+	return "the value"
+}
+
+// ValidateHTTPHeaders is a SchemaValidateDiagFunc for maps of http header names to values
+// header names must be valid, and hop-by-hop headers and headers set by the provider are rejected
+func ValidateHTTPHeaders(i interface{}, path cty.Path) diag.Diagnostics {
+	headers, ok := i.(map[string]interface{})
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Expected a map",
+			Detail:        fmt.Sprintf("Expected type of %s to be map, got %T.", attributeName(path), i),
+			AttributePath: path,
+		}}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, name := range names {
+		switch {
+		case !httpHeaderNamePattern.MatchString(name):
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid header name",
+				Detail:        fmt.Sprintf("%q in %s is not a valid HTTP header name.", name, attributeName(path)),
+				AttributePath: path,
+			})
+		case slices.Contains(reservedHTTPHeaders, http.CanonicalHeaderKey(name)):
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Reserved header name",
+				Detail:        fmt.Sprintf("%q in %s is a hop-by-hop header or a header set by the provider, and cannot be overridden.", http.CanonicalHeaderKey(name), attributeName(path)),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateUUID(t *testing.T) {
+	path := cty.GetAttrPath("group_mapping").IndexInt(0).GetAttr("provider_group_id")
+
+	tests := []struct {
+		value           interface{}
+		allowIdentifier bool
+		valid           bool
+	}{
+		{"0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", false, true},
+		{"0A1B2C3D-4E5F-6A7B-8C9D-0E1F2A3B4C5D", false, true},
+		{"0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5", false, false},
+		{" 0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", false, false},
+		{"engineering", false, false},
+		{"", false, false},
+		{123, false, false},
+		{"0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d", true, true},
+		{"engineering", true, true},
+		{"global.admin", true, true},
+		{"SSO-Example", true, true},
+		{"0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5", true, false},
+		{"", true, false},
+	}
+
+	for _, tc := range tests {
+		diags := validateUUID(tc.value, path, tc.allowIdentifier)
+		if diags.HasError() == tc.valid {
+			t.Fatalf("Got:\n\n%#v\n\nExpected valid=%t for %#v\n", diags, tc.valid, tc.value)
+		}
+		if diags.HasError() && !diags[0].AttributePath.Equals(path) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", diags[0].AttributePath, path)
+		}
+	}
+}
+
+func TestValidateHTTPHeaders(t *testing.T) {
+	path := cty.GetAttrPath("extra_headers")
+
+	tests := []struct {
+		value interface{}
+		valid bool
+	}{
+		{map[string]interface{}{}, true},
+		{map[string]interface{}{"X-Gateway-Route": "wiz-us1", "x-request-source": "terraform"}, true},
+		{map[string]interface{}{"X Gateway": "wiz-us1"}, false},
+		{map[string]interface{}{"X-Gateway:": "wiz-us1"}, false},
+		{map[string]interface{}{"": "wiz-us1"}, false},
+		{map[string]interface{}{"connection": "close"}, false},
+		{map[string]interface{}{"Transfer-Encoding": "chunked"}, false},
+		{map[string]interface{}{"Authorization": "Bearer token"}, false},
+		{map[string]interface{}{"x-wiz-tenant": "0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"}, false},
+		{"X-Gateway-Route", false},
+	}
+
+	for _, tc := range tests {
+		diags := ValidateHTTPHeaders(tc.value, path)
+		if diags.HasError() == tc.valid {
+			t.Fatalf("Got:\n\n%#v\n\nExpected valid=%t for %#v\n", diags, tc.valid, tc.value)
+		}
+	}
+}
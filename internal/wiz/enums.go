@@ -710,3 +710,77 @@ var GcpPubSubIntegrationAccessMethodType = []string{
 	"CONNECTOR_CREDENTIALS",
 	"SERVICE_ACCOUNT_KEY",
 }
+
+// ViewerType enum -- provider-side mapping of the viewer graphql type name
+var ViewerType = []string{
+	"USER",
+	"SERVICE_ACCOUNT",
+}
+
+// ProjectDeleteBehavior enum -- provider-side choice of how a destroyed project is removed
+var ProjectDeleteBehavior = []string{
+	"archive",
+	"delete",
+}
+
+// ListOrderBy enum -- provider-side ordering of list data source results
+var ListOrderBy = []string{
+	"name",
+	"id",
+}
+
+// ArchivedProjectPolicy enum -- provider-side handling of archived projects referenced by group mappings
+var ArchivedProjectPolicy = []string{
+	"keep",
+	"drop",
+	"error",
+}
+
+// GroupMappingProjectLimitPolicy enum -- provider-side handling of group mappings with more projects than group_mapping_project_limit
+var GroupMappingProjectLimitPolicy = []string{
+	"warn",
+	"error",
+}
+
+// DiagnosticDetailLevel enum -- provider-side amount of detail in the diagnostics of failed requests
+var DiagnosticDetailLevel = []string{
+	"minimal",
+	"normal",
+	"verbose",
+}
+
+// SAMLGroupMappingManifestFormat enum -- provider-side file formats of group mapping manifests
+var SAMLGroupMappingManifestFormat = []string{
+	"json",
+	"csv",
+}
+
+// SAMLGroupMappingDriftStatus enum -- provider-side drift states reported by wiz_saml_group_mapping_drift
+var SAMLGroupMappingDriftStatus = []string{
+	"in_sync",
+	"role_changed",
+	"projects_changed",
+	"missing",
+}
+
+// NotificationRuleChannel enum
+var NotificationRuleChannel = []string{
+	"EMAIL",
+	"SLACK",
+	"TEAMS",
+}
+
+// OutpostServiceType enum
+var OutpostServiceType = []string{
+	"AWS",
+	"AZURE",
+	"GCP",
+	"OCI",
+}
+
+// RoleType enum -- provider-side filter for built-in and custom roles
+var RoleType = []string{
+	"all",
+	"builtin",
+	"custom",
+}
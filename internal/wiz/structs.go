@@ -151,6 +151,16 @@ type UpdateProjectPayload struct {
 	Project Project `json:"project"`
 }
 
+// DeleteProjectInput struct
+type DeleteProjectInput struct {
+	ID string `json:"id"`
+}
+
+// DeleteProjectPayload struct
+type DeleteProjectPayload struct {
+	Stub string `json:"_stub"`
+}
+
 // UpdateProjectPatch struct
 // We deviate from the GraphQL schema to include resource links because the update requires an empty value to nullify removed attributes
 // The slug is required in the request in order to override update and deletion contexts
@@ -170,6 +180,23 @@ type UpdateProjectPatch struct {
 	Slug                   string                               `json:"slug"`
 }
 
+// UpdateProjectAttributes is used by wiz_projects to update the attributes it manages.
+// UpdateProjectPatch always sends the links and slug, which would clear them on projects created by wiz_projects.
+type UpdateProjectAttributes struct {
+	ID    string                 `json:"id"`
+	Patch PatchProjectAttributes `json:"patch"`
+}
+
+// PatchProjectAttributes is the patch of UpdateProjectAttributes, nil fields are left unchanged
+type PatchProjectAttributes struct {
+	Archived        *bool   `json:"archived,omitempty"`
+	BusinessUnit    *string `json:"businessUnit,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	ParentProjectID *string `json:"parentProjectId,omitempty"`
+	Slug            string  `json:"slug,omitempty"`
+}
+
 // UpdateProjectCloudAccountLinks represents the input for updating project cloud account links.
 // It includes the ID and a Patch object. The type was initially considered to be UpdateProjectInput,
 // but due to potential breaking changes with the addition of 'omitempty' to certain fields, this separate struct is used.
@@ -206,6 +233,19 @@ type UpdateSAMLIdentityProviderPatch struct {
 	MergeGroupsMappingByRole *bool                         `json:"mergeGroupsMappingByRole"`
 }
 
+// UpdateSAMLIdentityProviderMergeMode represents the input for updating only the group mapping merge mode.
+// UpdateSAMLIdentityProviderPatch sends every attribute to nullify removed values, so this separate struct
+// is used to avoid overwriting settings that are managed outside of this resource.
+type UpdateSAMLIdentityProviderMergeMode struct {
+	ID    string                             `json:"id"`
+	Patch PatchSAMLIdentityProviderMergeMode `json:"patch"`
+}
+
+// PatchSAMLIdentityProviderMergeMode struct
+type PatchSAMLIdentityProviderMergeMode struct {
+	MergeGroupsMappingByRole *bool `json:"mergeGroupsMappingByRole"`
+}
+
 // UpdateSAMLIdentityProviderPayload struct -- updates
 type UpdateSAMLIdentityProviderPayload struct {
 	SAMLIdentityProvider SAMLIdentityProvider `json:"samlIdentityProvider"`
@@ -215,7 +255,7 @@ type UpdateSAMLIdentityProviderPayload struct {
 type SAMLGroupMappingUpdateInput struct {
 	ProviderGroupID string   `json:"providerGroupId"`
 	Role            string   `json:"role"`
-	Projects        []string `json:"projects"`
+	Projects        []string `json:"projects,omitempty"` // omitted for mappings that apply to all projects
 }
 
 // CreateSAMLIdentityProviderInput struct -- updates
@@ -241,7 +281,7 @@ type CreateSAMLIdentityProviderPayload struct {
 type SAMLGroupMappingCreateInput struct {
 	ProviderGroupID string   `json:"providerGroupId"`
 	Role            string   `json:"role"`
-	Projects        []string `json:"projects"`
+	Projects        []string `json:"projects,omitempty"` // omitted for mappings that apply to all projects
 }
 
 // SAMLIdentityProvider struct -- updates
@@ -259,6 +299,13 @@ type SAMLIdentityProvider struct {
 	UseProviderManagedRoles  bool                `json:"useProviderManagedRoles"`
 }
 
+// SAMLIdentityProviderConnection struct
+type SAMLIdentityProviderConnection struct {
+	Nodes      []*SAMLIdentityProvider `json:"nodes,omitempty"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
 // SAMLGroupMapping struct -- updates
 type SAMLGroupMapping struct {
 	Projects        []Project `json:"projects"`
@@ -391,6 +438,13 @@ type UserRole struct {
 	Scopes          []string `json:"scopes"`
 }
 
+// UserRoleConnection struct
+type UserRoleConnection struct {
+	Nodes      []*UserRole `json:"nodes,omitempty"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
 // UserPreferences struct
 type UserPreferences struct {
 	SelectedSAMLGroup SAMLGroupMapping `json:"selectedSAMLGroup"`
@@ -763,6 +817,13 @@ type ServiceAccount struct {
 	LastRotatedAt    string     `json:"lastRotatedAt"`
 }
 
+// ServiceAccountConnection struct
+type ServiceAccountConnection struct {
+	Nodes      []*ServiceAccount `json:"nodes,omitempty"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
 // CreateServiceAccountInput struct -- updates
 type CreateServiceAccountInput struct {
 	Name               string   `json:"name"`
@@ -1045,11 +1106,23 @@ type SecuritySubCategory struct {
 
 // SecurityCategory struct -- updates
 type SecurityCategory struct {
-	Description   string                `json:"description"`
-	Framework     SecurityFramework     `json:"framework"`
-	ID            string                `json:"id"`
-	Name          string                `json:"name"`
-	SubCategories []SecuritySubCategory `json:"subCategories"`
+	Analytics     *SecurityCategoryAnalytics `json:"analytics,omitempty"`
+	Description   string                     `json:"description"`
+	Framework     SecurityFramework          `json:"framework"`
+	ID            string                     `json:"id"`
+	Name          string                     `json:"name"`
+	SubCategories []SecuritySubCategory      `json:"subCategories"`
+}
+
+// SecurityCategoryAnalytics struct -- compliance posture for a security category
+type SecurityCategoryAnalytics struct {
+	FailedCount int `json:"failedCount"`
+	PassedCount int `json:"passedCount"`
+}
+
+// SecurityCategoryAnalyticsSelection struct
+type SecurityCategoryAnalyticsSelection struct {
+	Project []string `json:"project,omitempty"`
 }
 
 // DeleteCloudConfigurationRuleInput struct -- updates
@@ -1374,6 +1447,28 @@ type IssueEntityTag struct {
 	Value string `json:"value,omitempty"`
 }
 
+// Issue struct
+type Issue struct {
+	ID             string               `json:"id"`
+	Severity       string               `json:"severity"` // enum Severity
+	Status         string               `json:"status"`   // enum IssueStatus
+	EntitySnapshot *IssueEntitySnapshot `json:"entitySnapshot,omitempty"`
+}
+
+// IssueEntitySnapshot struct -- the entity an issue was raised on, as seen when the issue was last updated
+type IssueEntitySnapshot struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// IssueConnection struct
+type IssueConnection struct {
+	Nodes      []*Issue `json:"nodes,omitempty"`
+	PageInfo   PageInfo `json:"pageInfo"`
+	TotalCount int      `json:"totalCount"`
+}
+
 // UpdateControlsPatch struct
 type UpdateControlsPatch struct {
 	Severity              string   `json:"severity,omitempty"`
@@ -1646,6 +1741,19 @@ type KubernetesClusterConnection struct {
 	TotalCount int                  `json:"totalCount"`
 }
 
+// ProjectFilters struct
+type ProjectFilters struct {
+	IncludeArchived *bool    `json:"includeArchived,omitempty"`
+	Slug            []string `json:"slug,omitempty"`
+}
+
+// ProjectConnection struct
+type ProjectConnection struct {
+	Nodes      []*Project `json:"nodes,omitempty"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
 // UserConnection struct
 type UserConnection struct {
 	Nodes      []*User  `json:"nodes,omitempty"`
@@ -1736,9 +1844,13 @@ type GraphDirectedRelationshipTypeInput struct {
 
 // GraphEntity struct
 // We deviate from the GraphQL schema and omit unused fields due to the high number of vertices
+// Type, Properties and Projects are only selected when reading a single entity
 type GraphEntity struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type,omitempty"` // enum GraphEntityType
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	Projects   []*Project             `json:"projects,omitempty"`
 }
 
 // GraphSearchResult struct
@@ -2627,3 +2739,296 @@ type DeleteReportPayload struct {
 type DeleteReportInput struct {
 	ID string `json:"id"`
 }
+
+// DataClassifier struct
+type DataClassifier struct {
+	Builtin     bool   `json:"builtin"`
+	Category    string `json:"category"` // enum DataFindingCategory
+	Description string `json:"description,omitempty"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+}
+
+// DataClassifierConnection struct
+type DataClassifierConnection struct {
+	Nodes      []*DataClassifier `json:"nodes,omitempty"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// DataClassifierFilters struct
+type DataClassifierFilters struct {
+	Search string `json:"search,omitempty"`
+}
+
+// Viewer struct -- the authenticated principal
+type Viewer struct {
+	Email    string   `json:"email,omitempty"`
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Scopes   []string `json:"scopes,omitempty"`
+	Tenant   Tenant   `json:"tenant"`
+	TypeName string   `json:"__typename,omitempty"` // User or ServiceAccount
+}
+
+// Tenant struct
+type Tenant struct {
+	ID string `json:"id"`
+}
+
+// SavedGraphQuery struct
+type SavedGraphQuery struct {
+	ID    string          `json:"id"`
+	Name  string          `json:"name"`
+	Query json.RawMessage `json:"query"`
+}
+
+// SavedGraphQueryConnection struct
+type SavedGraphQueryConnection struct {
+	Nodes      []*SavedGraphQuery `json:"nodes,omitempty"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+// SavedGraphQueryFilters struct
+type SavedGraphQueryFilters struct {
+	Search string `json:"search,omitempty"`
+}
+
+// ConfigurationFinding struct
+type ConfigurationFinding struct {
+	Evidence *ConfigurationFindingEvidence `json:"evidence,omitempty"`
+	ID       string                        `json:"id"`
+	Resource ConfigurationFindingResource  `json:"resource"`
+	Result   string                        `json:"result"` // enum CloudConfigurationRuleResult
+}
+
+// ConfigurationFindingEvidence struct
+type ConfigurationFindingEvidence struct {
+	ConfigurationPath string `json:"configurationPath,omitempty"`
+	CurrentValue      string `json:"currentValue,omitempty"`
+	ExpectedValue     string `json:"expectedValue,omitempty"`
+}
+
+// ConfigurationFindingResource struct
+type ConfigurationFindingResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ConfigurationFindingConnection struct
+type ConfigurationFindingConnection struct {
+	Nodes      []*ConfigurationFinding `json:"nodes,omitempty"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
+// ConfigurationFindingFilters struct
+type ConfigurationFindingFilters struct {
+	ProjectID []string                             `json:"projectId,omitempty"`
+	Resource  *ConfigurationFindingResourceFilters `json:"resource,omitempty"`
+	Result    []string                             `json:"result,omitempty"` // enum CloudConfigurationRuleResult
+	Rule      *ConfigurationFindingRuleFilters     `json:"rule,omitempty"`
+}
+
+// ConfigurationFindingResourceFilters struct
+type ConfigurationFindingResourceFilters struct {
+	ID []string `json:"id,omitempty"`
+}
+
+// ConfigurationFindingRuleFilters struct
+type ConfigurationFindingRuleFilters struct {
+	ID []string `json:"id,omitempty"`
+}
+
+// AuditLogEntry struct
+type AuditLogEntry struct {
+	Action           string              `json:"action"`
+	ActionParameters json.RawMessage     `json:"actionParameters,omitempty"`
+	ID               string              `json:"id"`
+	ServiceAccount   *AuditLogEntryActor `json:"serviceAccount,omitempty"`
+	Status           string              `json:"status"`
+	Timestamp        string              `json:"timestamp"`
+	User             *AuditLogEntryActor `json:"user,omitempty"`
+}
+
+// AuditLogEntryActor struct
+type AuditLogEntryActor struct {
+	Email string `json:"email,omitempty"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+}
+
+// AuditLogEntryConnection struct
+type AuditLogEntryConnection struct {
+	Nodes      []*AuditLogEntry `json:"nodes,omitempty"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+// AuditLogEntryFilters struct
+type AuditLogEntryFilters struct {
+	Action    []string                      `json:"action,omitempty"`
+	Timestamp *AuditLogEntryTimestampFilter `json:"timestamp,omitempty"`
+}
+
+// AuditLogEntryTimestampFilter struct
+type AuditLogEntryTimestampFilter struct {
+	After  string `json:"after,omitempty"`
+	Before string `json:"before,omitempty"`
+}
+
+// Dashboard struct
+type Dashboard struct {
+	ID       string          `json:"id"`
+	IsShared bool            `json:"isShared"`
+	Name     string          `json:"name"`
+	Project  *Project        `json:"project,omitempty"`
+	Widgets  json.RawMessage `json:"widgets"`
+}
+
+// CreateDashboardInput struct
+type CreateDashboardInput struct {
+	IsShared  *bool           `json:"isShared,omitempty"`
+	Name      string          `json:"name"`
+	ProjectID string          `json:"projectId,omitempty"`
+	Widgets   json.RawMessage `json:"widgets"`
+}
+
+// CreateDashboardPayload struct
+type CreateDashboardPayload struct {
+	Dashboard Dashboard `json:"dashboard"`
+}
+
+// UpdateDashboardInput struct
+type UpdateDashboardInput struct {
+	ID    string               `json:"id"`
+	Patch UpdateDashboardPatch `json:"patch"`
+}
+
+// UpdateDashboardPatch struct
+type UpdateDashboardPatch struct {
+	IsShared *bool           `json:"isShared,omitempty"`
+	Name     string          `json:"name,omitempty"`
+	Widgets  json.RawMessage `json:"widgets,omitempty"`
+}
+
+// UpdateDashboardPayload struct
+type UpdateDashboardPayload struct {
+	Dashboard Dashboard `json:"dashboard"`
+}
+
+// DeleteDashboardInput struct
+type DeleteDashboardInput struct {
+	ID string `json:"id"`
+}
+
+// DeleteDashboardPayload struct
+type DeleteDashboardPayload struct {
+	Stub string `json:"_stub"`
+}
+
+// Outpost struct
+type Outpost struct {
+	Config      json.RawMessage `json:"config"`
+	Enabled     bool            `json:"enabled"`
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	ServiceType string          `json:"serviceType"` // enum OutpostServiceType
+	Status      string          `json:"status"`      // enum OutpostStatus
+}
+
+// CreateOutpostInput struct
+type CreateOutpostInput struct {
+	Config      json.RawMessage `json:"config"`
+	Enabled     *bool           `json:"enabled,omitempty"`
+	Name        string          `json:"name"`
+	ServiceType string          `json:"serviceType"`
+}
+
+// CreateOutpostPayload struct
+type CreateOutpostPayload struct {
+	Outpost Outpost `json:"outpost"`
+}
+
+// UpdateOutpostInput struct
+type UpdateOutpostInput struct {
+	ID    string             `json:"id"`
+	Patch UpdateOutpostPatch `json:"patch"`
+}
+
+// UpdateOutpostPatch struct
+type UpdateOutpostPatch struct {
+	Config  json.RawMessage `json:"config,omitempty"`
+	Enabled *bool           `json:"enabled,omitempty"`
+	Name    string          `json:"name,omitempty"`
+}
+
+// UpdateOutpostPayload struct
+type UpdateOutpostPayload struct {
+	Outpost Outpost `json:"outpost"`
+}
+
+// DeleteOutpostInput struct
+type DeleteOutpostInput struct {
+	ID string `json:"id"`
+}
+
+// DeleteOutpostPayload struct
+type DeleteOutpostPayload struct {
+	Stub string `json:"_stub"`
+}
+
+// NotificationRule struct
+type NotificationRule struct {
+	Channel    string          `json:"channel"` // enum NotificationRuleChannel
+	Enabled    bool            `json:"enabled"`
+	Filters    json.RawMessage `json:"filters"`
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Recipients []string        `json:"recipients"`
+}
+
+// CreateNotificationRuleInput struct
+type CreateNotificationRuleInput struct {
+	Channel    string          `json:"channel"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+	Filters    json.RawMessage `json:"filters,omitempty"`
+	Name       string          `json:"name"`
+	Recipients []string        `json:"recipients"`
+}
+
+// CreateNotificationRulePayload struct
+type CreateNotificationRulePayload struct {
+	NotificationRule NotificationRule `json:"notificationRule"`
+}
+
+// UpdateNotificationRuleInput struct
+type UpdateNotificationRuleInput struct {
+	ID    string                      `json:"id"`
+	Patch UpdateNotificationRulePatch `json:"patch"`
+}
+
+// UpdateNotificationRulePatch struct
+type UpdateNotificationRulePatch struct {
+	Channel    string          `json:"channel,omitempty"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+	Filters    json.RawMessage `json:"filters,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Recipients []string        `json:"recipients,omitempty"`
+}
+
+// UpdateNotificationRulePayload struct
+type UpdateNotificationRulePayload struct {
+	NotificationRule NotificationRule `json:"notificationRule"`
+}
+
+// DeleteNotificationRuleInput struct
+type DeleteNotificationRuleInput struct {
+	ID string `json:"id"`
+}
+
+// DeleteNotificationRulePayload struct
+type DeleteNotificationRulePayload struct {
+	Stub string `json:"_stub"`
+}